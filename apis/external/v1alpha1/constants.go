@@ -30,6 +30,19 @@ func (p Policy) String() string {
 	return string(p)
 }
 
+// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicy
+	// DeletionPolicyDelete deletes the owned DNS records together with their ownership records
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain keeps the DNS records and their ownership records
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyOrphan keeps the DNS records but deletes their ownership records, so another owner can adopt them
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
 type ExternalDNSPhase string
 
 const (
@@ -37,26 +50,131 @@ const (
 	ExternalDNSPhaseCurrent    ExternalDNSPhase = "Current"
 	ExternalDNSPhaseFailed     ExternalDNSPhase = "Failed"
 	ExternalDNSPhaseInProgress ExternalDNSPhase = "InProgress"
+	// ExternalDNSPhaseWaitingForApproval means a computed plan is not applied until it is approved
+	ExternalDNSPhaseWaitingForApproval ExternalDNSPhase = "WaitingForApproval"
 )
 
-// +kubebuilder:validation:Enum=aws;cloudflare;azure;google
+// +kubebuilder:validation:Enum=Automatic;Manual;DeletesOnly
+type ApprovalPolicy string
+
+const (
+	// ApprovalPolicy
+	ApprovalAutomatic   ApprovalPolicy = "Automatic"
+	ApprovalManual      ApprovalPolicy = "Manual"
+	ApprovalDeletesOnly ApprovalPolicy = "DeletesOnly"
+)
+
+const (
+	// ApprovePlanAnnotation approves the plan whose hash is the value of the annotation
+	ApprovePlanAnnotation = "external-dns.appscode.com/approve-plan"
+
+	// OverrideDeleteSafetyAnnotation set to "true" lets the records be deleted on finalization beyond spec.safety.maxDeletesPerSync
+	OverrideDeleteSafetyAnnotation = "external-dns.appscode.com/override-delete-safety"
+)
+
+// +kubebuilder:validation:Enum=aws;aws-sd;cloudflare;azure;google;rfc2136;pdns;coredns;webhook;digitalocean;linode;scaleway;civo;dnsimple;gandi;godaddy;akamai;oci;pihole;ns1;ovh;transip;exoscale;alibabacloud;inmemory
 type Provider string
 
 const (
 	// Provider
-	ProviderAWS        Provider = "aws"
-	ProviderCloudflare Provider = "cloudflare"
-	ProviderAzure      Provider = "azure"
-	ProviderGoogle     Provider = "google"
+	ProviderAWS          Provider = "aws"
+	ProviderAWSSD        Provider = "aws-sd"
+	ProviderCloudflare   Provider = "cloudflare"
+	ProviderAzure        Provider = "azure"
+	ProviderGoogle       Provider = "google"
+	ProviderRFC2136      Provider = "rfc2136"
+	ProviderPDNS         Provider = "pdns"
+	ProviderCoreDNS      Provider = "coredns"
+	ProviderWebhook      Provider = "webhook"
+	ProviderDigitalOcean Provider = "digitalocean"
+	ProviderLinode       Provider = "linode"
+	ProviderScaleway     Provider = "scaleway"
+	ProviderCivo         Provider = "civo"
+	ProviderDNSimple     Provider = "dnsimple"
+	ProviderGandi        Provider = "gandi"
+	ProviderGoDaddy      Provider = "godaddy"
+	ProviderAkamai       Provider = "akamai"
+	ProviderOCI          Provider = "oci"
+	ProviderPihole       Provider = "pihole"
+	ProviderNS1          Provider = "ns1"
+	ProviderOVH          Provider = "ovh"
+	ProviderTransIP      Provider = "transip"
+	ProviderExoscale     Provider = "exoscale"
+	ProviderAlibabaCloud Provider = "alibabacloud"
+	ProviderInMemory     Provider = "inmemory"
 )
 
 func (p Provider) String() string {
 	return string(p)
 }
 
+// +kubebuilder:validation:Enum=txt;noop;aws-sd;dynamodb
+type RegistryType string
+
+const (
+	// Registry
+	// RegistryTXT keeps the ownership of the records in TXT records next to them
+	RegistryTXT RegistryType = "txt"
+	// RegistryNoop keeps no ownership records, every record of the zones is managed
+	RegistryNoop RegistryType = "noop"
+	// RegistryAWSSD keeps the ownership of the records in the AWS Cloud Map services of the aws-sd provider
+	RegistryAWSSD RegistryType = "aws-sd"
+	// RegistryDynamoDB keeps the ownership of the records in a DynamoDB table, with the credentials of the aws or aws-sd provider
+	RegistryDynamoDB RegistryType = "dynamodb"
+)
+
+func (r RegistryType) String() string {
+	return string(r)
+}
+
+// +kubebuilder:validation:Enum=public;private
+type AzureZoneType string
+
+const (
+	// AzureZoneType
+	// AzureZoneTypePublic manages the records of public Azure DNS zones
+	AzureZoneTypePublic AzureZoneType = "public"
+	// AzureZoneTypePrivate manages the records of Azure Private DNS zones, linked to virtual networks
+	AzureZoneTypePrivate AzureZoneType = "private"
+)
+
+// +kubebuilder:validation:Enum=ConfigFile;InstancePrincipal
+type OCIAuthMode string
+
+const (
+	// OCIAuthMode
+	// OCIAuthConfigFile authenticates with the OCI config file of the provider secret
+	OCIAuthConfigFile OCIAuthMode = "ConfigFile"
+	// OCIAuthInstancePrincipal authenticates as the instance principal of the node the operator runs on
+	OCIAuthInstancePrincipal OCIAuthMode = "InstancePrincipal"
+)
+
+const (
+	// DNSEndpoint is the custom resource read by the crd source
+	DNSEndpointGroup   = "externaldns.k8s.io"
+	DNSEndpointVersion = "v1alpha1"
+	DNSEndpointKind    = "DNSEndpoint"
+)
+
 const (
 	// ConditionType
 	CreateAndRegisterWatcher = "CreateAndRegisterWatcher"
 	GetProviderSecret        = "GetProviderSecret"
 	CreateAndApplyPlan       = "CreateAndApplyPlan"
+	DeleteDNSRecords         = "DeleteDNSRecords"
+	ReconcileWebhookServer   = "ReconcileWebhookServer"
+	OwnershipTracking        = "OwnershipTracking"
+)
+
+const (
+	// MaxDeletesExceeded is the reason of the condition and event, when a plan deletes more records than allowed
+	MaxDeletesExceeded = "MaxDeletesExceeded"
+
+	// DryRunDeletion is the reason of the DeleteDNSRecords condition and event, when the records of a deleted
+	// ExternalDNS are kept because of the dry run mode
+	DryRunDeletion = "DryRunDeletion"
+
+	// NoOwnershipRecords is the reason of the OwnershipTracking condition, when the provider cannot store
+	// ownership records and the noop registry is used
+	NoOwnershipRecords = "NoOwnershipRecords"
 )
@@ -0,0 +1,99 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IsSet reports whether a source type or a crd source is configured
+func (s SourceConfig) IsSet() bool {
+	return s.Type.Kind != "" || s.CRD != nil
+}
+
+// GetSources returns every source of the ExternalDNS, the single source field first
+func (s ExternalDNSSpec) GetSources() []SourceConfig {
+	sources := make([]SourceConfig, 0, len(s.Sources)+1)
+	if s.Source.IsSet() {
+		sources = append(sources, s.Source)
+	}
+	return append(sources, s.Sources...)
+}
+
+// IsCRDSource reports whether the source reads endpoints from DNSEndpoint custom resources,
+// either by selecting the DNSEndpoint type or by configuring the crd source explicitly
+func (s SourceConfig) IsCRDSource() bool {
+	return s.CRD != nil || (s.Type.Group == DNSEndpointGroup && s.Type.Kind == DNSEndpointKind)
+}
+
+// CRDSourceGroupVersionKind returns the type of the custom resources read by the crd source.
+// When the crd config is set, its apiVersion and kind decide the type and default to
+// externaldns.k8s.io/v1alpha1 and DNSEndpoint, otherwise the source type is read.
+func (s SourceConfig) CRDSourceGroupVersionKind() (schema.GroupVersionKind, error) {
+	if s.CRD == nil {
+		return s.Type.GroupVersionKind(), nil
+	}
+
+	gvk := schema.GroupVersionKind{Group: DNSEndpointGroup, Version: DNSEndpointVersion, Kind: DNSEndpointKind}
+	if s.CRD.APIVersion != nil && *s.CRD.APIVersion != "" {
+		gv, err := schema.ParseGroupVersion(*s.CRD.APIVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, fmt.Errorf("invalid crd apiVersion %q: %w", *s.CRD.APIVersion, err)
+		}
+		gvk.Group, gvk.Version = gv.Group, gv.Version
+	}
+	if s.CRD.Kind != nil && *s.CRD.Kind != "" {
+		gvk.Kind = *s.CRD.Kind
+	}
+	return gvk, nil
+}
+
+// GetDeletionPolicy returns the deletion policy, which defaults to Delete when records are
+// synced and to Retain when the policy never deletes records
+func (s ExternalDNSSpec) GetDeletionPolicy() DeletionPolicy {
+	if s.DeletionPolicy != nil {
+		return *s.DeletionPolicy
+	}
+	if s.Policy == nil || *s.Policy == PolicySync {
+		return DeletionPolicyDelete
+	}
+	return DeletionPolicyRetain
+}
+
+// GetTXTEncryption returns the encryption of the ownership records of the TXT registry, nil when they are not encrypted
+func (s ExternalDNSSpec) GetTXTEncryption() *TXTEncryption {
+	if s.Registry == nil || s.Registry.TXT == nil {
+		return nil
+	}
+	return s.Registry.TXT.Encryption
+}
+
+// WebhookServerName is the name of the Deployment and the Service of the webhook server run for the ExternalDNS
+func (e *ExternalDNS) WebhookServerName() string {
+	return e.Name + "-webhook"
+}
+
+// WebhookServerURL returns the URL of the webhook server run for the ExternalDNS
+func (e *ExternalDNS) WebhookServerURL() string {
+	port := int32(8888)
+	if e.Spec.Webhook != nil && e.Spec.Webhook.Server != nil && e.Spec.Webhook.Server.Port != nil {
+		port = *e.Spec.Webhook.Server.Port
+	}
+	return fmt.Sprintf("http://%s.%s.svc:%d", e.WebhookServerName(), e.Namespace, port)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+func TestCRDSourceGroupVersionKind(t *testing.T) {
+	dnsEndpoint := schema.GroupVersionKind{Group: DNSEndpointGroup, Version: DNSEndpointVersion, Kind: DNSEndpointKind}
+
+	for _, tc := range []struct {
+		name    string
+		src     SourceConfig
+		want    schema.GroupVersionKind
+		wantErr bool
+	}{
+		{
+			name: "source type",
+			src:  SourceConfig{Type: TypeInfo{Group: DNSEndpointGroup, Version: "v1alpha1", Kind: DNSEndpointKind}},
+			want: dnsEndpoint,
+		},
+		{
+			name: "crd without apiVersion and kind",
+			src:  SourceConfig{CRD: &CRDConfig{Namespace: ptr.To("demo")}},
+			want: dnsEndpoint,
+		},
+		{
+			name: "crd defaults do not fall back to the source type",
+			src:  SourceConfig{Type: TypeInfo{Version: "v1", Kind: "Service"}, CRD: &CRDConfig{}},
+			want: dnsEndpoint,
+		},
+		{
+			name: "crd apiVersion and kind",
+			src:  SourceConfig{CRD: &CRDConfig{APIVersion: ptr.To("example.com/v1"), Kind: ptr.To("Endpoint")}},
+			want: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Endpoint"},
+		},
+		{
+			name:    "invalid crd apiVersion",
+			src:     SourceConfig{CRD: &CRDConfig{APIVersion: ptr.To("example.com/v1/extra")}},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.src.CRDSourceGroupVersionKind()
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("CRDSourceGroupVersionKind() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetSources(t *testing.T) {
+	service := SourceConfig{Type: TypeInfo{Version: "v1", Kind: "Service"}}
+	ingress := SourceConfig{Type: TypeInfo{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"}}
+	node := SourceConfig{Type: TypeInfo{Version: "v1", Kind: "Node"}}
+
+	for _, tc := range []struct {
+		name string
+		spec ExternalDNSSpec
+		want []SourceConfig
+	}{
+		{name: "source only", spec: ExternalDNSSpec{Source: service}, want: []SourceConfig{service}},
+		{name: "sources only", spec: ExternalDNSSpec{Sources: []SourceConfig{ingress, node}}, want: []SourceConfig{ingress, node}},
+		{name: "source first", spec: ExternalDNSSpec{Source: service, Sources: []SourceConfig{ingress, node}}, want: []SourceConfig{service, ingress, node}},
+		{name: "none", spec: ExternalDNSSpec{}, want: []SourceConfig{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.spec.GetSources()
+			if len(got) != len(tc.want) {
+				t.Fatalf("GetSources() returned %d sources, want %d", len(got), len(tc.want))
+			}
+			for i := range got {
+				if got[i].Type != tc.want[i].Type {
+					t.Fatalf("source %d is %s, want %s", i, got[i].Type.Kind, tc.want[i].Type.Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDeletionPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec ExternalDNSSpec
+		want DeletionPolicy
+	}{
+		{name: "default policy", spec: ExternalDNSSpec{}, want: DeletionPolicyDelete},
+		{name: "sync", spec: ExternalDNSSpec{Policy: ptr.To(PolicySync)}, want: DeletionPolicyDelete},
+		{name: "upsert-only", spec: ExternalDNSSpec{Policy: ptr.To(PolicyUpsertOnly)}, want: DeletionPolicyRetain},
+		{name: "create-only", spec: ExternalDNSSpec{Policy: ptr.To(PolicyCreateOnly)}, want: DeletionPolicyRetain},
+		{name: "explicit with sync", spec: ExternalDNSSpec{Policy: ptr.To(PolicySync), DeletionPolicy: ptr.To(DeletionPolicyOrphan)}, want: DeletionPolicyOrphan},
+		{name: "explicit with upsert-only", spec: ExternalDNSSpec{Policy: ptr.To(PolicyUpsertOnly), DeletionPolicy: ptr.To(DeletionPolicyDelete)}, want: DeletionPolicyDelete},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.spec.GetDeletionPolicy(); got != tc.want {
+				t.Fatalf("GetDeletionPolicy() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
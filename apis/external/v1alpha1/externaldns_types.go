@@ -19,8 +19,10 @@ package v1alpha1
 import (
 	"time"
 
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kmapi "kmodules.xyz/client-go/api/v1"
 )
 
@@ -81,7 +83,9 @@ type AWSProvider struct {
 	// +optional
 	SDServiceCleanup *bool `json:"sdServiceCleanup,omitempty"`
 
-	SDCreateTag *map[string]string `json:"sdCreateTag"`
+	// When using the AWS CloudMap provider, add these tags to created services
+	// +optional
+	SDCreateTag *map[string]string `json:"sdCreateTag,omitempty"`
 
 	// provider secret credential information
 	// +optional
@@ -110,7 +114,7 @@ type AzureProvider struct {
 	// +optional
 	ResourceGroup *string `json:"resourceGroup,omitempty"`
 
-	// When using the Azure provider, specify the Azure configuration file. (required for azure-private-dns)
+	// When using the Azure provider, override the Azure subscription to use (required for azure-private-dns)
 	// +optional
 	SubscriptionId *string `json:"subscriptionId,omitempty"`
 
@@ -118,6 +122,16 @@ type AzureProvider struct {
 	// +optional
 	UserAssignedIdentityClientID *string `json:"userAssignedIdentityClientID,omitempty"`
 
+	// When using the Azure provider, manage the records of public DNS zones or of private DNS zones
+	// linked to virtual networks. Private zones require resourceGroup and subscriptionId. (default: public)
+	// +optional
+	ZoneType *AzureZoneType `json:"zoneType,omitempty"`
+
+	// When using the Azure provider, override the Active Directory authority host in config file, for
+	// sovereign clouds, e.g. `https://login.chinacloudapi.cn/` or `https://login.microsoftonline.us/`
+	// +optional
+	ActiveDirectoryAuthorityHost *string `json:"activeDirectoryAuthorityHost,omitempty"`
+
 	ZonesCacheDuration *time.Duration `json:"zonesCacheDuration"` // new
 	MaxRetriesCount    *int           `json:"maxRetriesCount"`
 
@@ -146,6 +160,361 @@ type GoogleProvider struct {
 	SecretRef *GenericSecretReference `json:"secretRef,omitempty"`
 }
 
+type RFC2136Provider struct {
+	// When using the RFC2136 provider, specify the host of the DNS server (the first host is used, unless a load
+	// balancing strategy is set)
+	Host []string `json:"host"`
+
+	// When using the RFC2136 provider, specify the port of the DNS server
+	// +optional
+	// +kubebuilder:default=53
+	Port *int `json:"port,omitempty"`
+
+	// When using the RFC2136 provider, specify the zones entries of the DNS server to use
+	// +optional
+	Zone []string `json:"zone,omitempty"`
+
+	// When using the RFC2136 provider, specify whether to attach TSIG or not (default: false, requires TSIG)
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// When using the RFC2136 provider, specify the TSIG key name to attach to DNS update messages
+	// +optional
+	TSIGKeyName *string `json:"tsigKeyName,omitempty"`
+
+	// When using the RFC2136 provider, specify the TSIG algorithm (options: hmac-sha1, hmac-sha224, hmac-sha256,
+	// hmac-sha384, hmac-sha512), required unless insecure or gssTSIG is set
+	// +optional
+	TSIGSecretAlg *string `json:"tsigSecretAlg,omitempty"`
+
+	// When using the RFC2136 provider, specify whether to use AXFR to list the records (default: true)
+	// +optional
+	TAXFR *bool `json:"taxfr,omitempty"`
+
+	// When using the RFC2136 provider, specify minimal TTL (in duration format) for records. This value will be
+	// used if the provided TTL for a service/ingress is lower than this
+	// +optional
+	MinTTL *time.Duration `json:"minTTL,omitempty"`
+
+	// When using the RFC2136 provider, set the maximum number of changes that will be applied in each batch
+	// +optional
+	BatchChangeSize *int `json:"batchChangeSize,omitempty"`
+
+	// When using the RFC2136 provider, enable PTR management
+	// +optional
+	CreatePTR *bool `json:"createPTR,omitempty"`
+
+	// When using the RFC2136 provider, specify the load balancing strategy for the hosts (default: disabled,
+	// options: disabled, round-robin, random)
+	// +optional
+	LoadBalancingStrategy *string `json:"loadBalancingStrategy,omitempty"`
+
+	// When using the RFC2136 provider, specify whether to use secure updates with GSS-TSIG using Kerberos
+	// (default: false, requires the kerberos username and password in the secret)
+	// +optional
+	GSSTSIG *bool `json:"gssTSIG,omitempty"`
+
+	// When using the RFC2136 provider with GSS-TSIG, specify the realm of the user credentials used for
+	// Kerberos authentication
+	// +optional
+	KerberosRealm *string `json:"kerberosRealm,omitempty"`
+
+	// When using the RFC2136 provider, communicate with the DNS server over TLS
+	// +optional
+	UseTLS *bool `json:"useTLS,omitempty"`
+
+	// When using the RFC2136 provider, disable verification of any TLS certificates
+	// +optional
+	SkipTLSVerify *bool `json:"skipTLSVerify,omitempty"`
+
+	// Provider secret credential information, not needed in insecure mode
+	// +optional
+	SecretRef *RFC2136SecretReference `json:"secretRef,omitempty"`
+}
+
+type PDNSProvider struct {
+	// When using the PowerDNS provider, specify the URL to the pdns server
+	Server string `json:"server"`
+
+	// When using the PowerDNS provider, specify the id of the server to retrieve. Should be `localhost` except
+	// when the server is behind a proxy (default: localhost)
+	// +optional
+	ServerID *string `json:"serverID,omitempty"`
+
+	// When using the PowerDNS provider, disable verification of any TLS certificates
+	// +optional
+	SkipTLSVerify *bool `json:"skipTLSVerify,omitempty"`
+
+	// Provider secret credential information
+	SecretRef *PDNSSecretReference `json:"secretRef"`
+}
+
+type CoreDNSProvider struct {
+	// When using the CoreDNS provider, specify the URLs of the etcd cluster CoreDNS reads the records from. The
+	// first URL decides whether TLS is used (default: http://localhost:2379)
+	// +optional
+	EtcdURLs []string `json:"etcdURLs,omitempty"`
+
+	// When using the CoreDNS provider, specify the prefix name (default: /skydns/)
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// When using the CoreDNS provider with TLS, override the server name used to verify the etcd certificate
+	// +optional
+	TLSServerName *string `json:"tlsServerName,omitempty"`
+
+	// When using the CoreDNS provider with TLS, disable verification of the etcd certificate
+	// +optional
+	TLSInsecure *bool `json:"tlsInsecure,omitempty"`
+
+	// Provider secret credential information, holding the etcd basic auth credentials and TLS certificates
+	// +optional
+	SecretRef *CoreDNSSecretReference `json:"secretRef,omitempty"`
+}
+
+type WebhookProvider struct {
+	// When using the webhook provider, specify the URL of the provider. It defaults to the service of the webhook
+	// server when server is set (default: http://localhost:8888)
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// When using the webhook provider, the timeout of requests reading from the provider (default: 5s)
+	// +optional
+	ReadTimeout *time.Duration `json:"readTimeout,omitempty"`
+
+	// When using the webhook provider, the timeout of requests applying changes to the provider (default: 10s)
+	// +optional
+	WriteTimeout *time.Duration `json:"writeTimeout,omitempty"`
+
+	// Provider secret holding the bearer token and TLS certificates used to call the webhook provider
+	// +optional
+	SecretRef *WebhookSecretReference `json:"secretRef,omitempty"`
+
+	// Server runs the webhook provider as a Deployment and a Service owned by the ExternalDNS
+	// +optional
+	Server *WebhookServer `json:"server,omitempty"`
+}
+
+// WebhookServer is the webhook provider run by the operator for a single ExternalDNS
+type WebhookServer struct {
+	// Image of the webhook provider
+	Image string `json:"image"`
+
+	// Arguments of the webhook provider
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Port the webhook provider listens on
+	// +optional
+	// +kubebuilder:default=8888
+	Port *int32 `json:"port,omitempty"`
+
+	// Environment variables of the webhook provider, usually the credentials of the DNS vendor
+	// +optional
+	Env []core.EnvVar `json:"env,omitempty"`
+
+	// Sources to populate environment variables of the webhook provider
+	// +optional
+	EnvFrom []core.EnvFromSource `json:"envFrom,omitempty"`
+
+	// Compute resources of the webhook provider
+	// +optional
+	Resources core.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type DigitalOceanProvider struct {
+	// When using the DigitalOcean provider, configure the page size used when querying the DigitalOcean API (default: 50)
+	// +optional
+	APIPageSize *int `json:"apiPageSize,omitempty"`
+
+	// Provider secret holding the DigitalOcean API token
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type LinodeProvider struct {
+	// When using the Linode provider, override the URL of the Linode API
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// Provider secret holding the Linode API token
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type ScalewayProvider struct {
+	// When using the Scaleway provider, override the URL of the Scaleway API
+	// +optional
+	APIURL *string `json:"apiURL,omitempty"`
+
+	// When using the Scaleway provider, configure the page size used when querying the Scaleway API (default: 1000)
+	// +optional
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// Provider secret holding the Scaleway access key, as apiKeyKey, and secret key, as apiSecretKey
+	SecretRef *APIKeySecretReference `json:"secretRef"`
+}
+
+type CivoProvider struct {
+	// Provider secret holding the Civo API token
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type DNSimpleProvider struct {
+	// When using the DNSimple provider, the account to manage the zones of. It is looked up from the token when not set.
+	// +optional
+	AccountID *string `json:"accountID,omitempty"`
+
+	// Provider secret holding the DNSimple OAuth token
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type GandiProvider struct {
+	// When using the Gandi provider, the sharing id of the organization to manage the domains of
+	// +optional
+	SharingID *string `json:"sharingID,omitempty"`
+
+	// Provider secret holding the Gandi personal access token
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type GoDaddyProvider struct {
+	// When using the GoDaddy provider, the TTL of the records, which is at least 600 (default: 600)
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// When using the GoDaddy provider, use the OTE (test) environment of the GoDaddy API (default: disabled)
+	// +optional
+	OTE *bool `json:"ote,omitempty"`
+
+	// Provider secret holding the GoDaddy API key and API secret
+	SecretRef *APIKeySecretReference `json:"secretRef"`
+}
+
+type AkamaiProvider struct {
+	// When using the Akamai provider, the section of the .edgerc file to read the credentials from (default: default)
+	// +optional
+	EdgercSection *string `json:"edgercSection,omitempty"`
+
+	// Provider secret holding either the EdgeGrid tokens or an .edgerc file
+	SecretRef *AkamaiSecretReference `json:"secretRef"`
+}
+
+type OCIProvider struct {
+	// When using the OCI provider, authenticate with the config file of the provider secret, or as the instance
+	// principal of the node (default: ConfigFile)
+	// +optional
+	AuthMode *OCIAuthMode `json:"authMode,omitempty"`
+
+	// When using the OCI provider, the OCID of the compartment containing the zones. Required with instance
+	// principal authentication, it overrides the compartment of the config file otherwise.
+	// +optional
+	CompartmentOCID *string `json:"compartmentOCID,omitempty"`
+
+	// When using the OCI provider, filter for zones with this scope (default: GLOBAL)
+	// +optional
+	// +kubebuilder:validation:Enum=GLOBAL;PRIVATE
+	ZoneScope *string `json:"zoneScope,omitempty"`
+
+	// When using the OCI provider, set the zones list cache TTL (0s to disable)
+	// +optional
+	ZoneCacheDuration *time.Duration `json:"zoneCacheDuration,omitempty"`
+
+	// Provider secret holding the OCI config file and its private key, required with config file authentication
+	// +optional
+	SecretRef *OCISecretReference `json:"secretRef,omitempty"`
+}
+
+type PiholeProvider struct {
+	// When using the Pi-hole provider, the base URL of the Pi-hole web server
+	Server string `json:"server"`
+
+	// When using the Pi-hole provider, skip verification of any TLS certificates served by the Pi-hole web server
+	// +optional
+	TLSInsecureSkipVerify *bool `json:"tlsInsecureSkipVerify,omitempty"`
+
+	// When using the Pi-hole provider, the API version of the Pi-hole web server (default: 5)
+	// +optional
+	// +kubebuilder:validation:Enum="5";"6"
+	APIVersion *string `json:"apiVersion,omitempty"`
+
+	// Provider secret holding the password of the Pi-hole web server, not needed when it has no password
+	// +optional
+	SecretRef *PiholeSecretReference `json:"secretRef,omitempty"`
+}
+
+type NS1Provider struct {
+	// When using the NS1 provider, the endpoint of the NS1 API, for a managed DNS or private DNS installation
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// When using the NS1 provider, skip the verification of the TLS certificate of the NS1 API (default: disabled)
+	// +optional
+	IgnoreSSL *bool `json:"ignoreSSL,omitempty"`
+
+	// When using the NS1 provider, the minimum TTL of the records in seconds
+	// +optional
+	MinTTLSeconds *int `json:"minTTLSeconds,omitempty"`
+
+	// Provider secret holding the NS1 API key
+	SecretRef *TokenSecretReference `json:"secretRef"`
+}
+
+type OVHProvider struct {
+	// When using the OVH provider, the name or the URL of the OVH API endpoint (default: ovh-eu)
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// When using the OVH provider, the maximum number of requests per second to the OVH API (default: 20)
+	// +optional
+	APIRateLimit *int `json:"apiRateLimit,omitempty"`
+
+	// When using the OVH provider, write the targets of CNAME records relative to their zone (default: disabled)
+	// +optional
+	EnableCNAMERelative *bool `json:"enableCNAMERelative,omitempty"`
+
+	// Provider secret holding the OVH application key, application secret and consumer key
+	SecretRef *OVHSecretReference `json:"secretRef"`
+}
+
+type TransIPProvider struct {
+	// When using the TransIP provider, the name of the TransIP account
+	AccountName string `json:"accountName"`
+
+	// Provider secret holding the private key of the TransIP API
+	SecretRef *TransIPSecretReference `json:"secretRef"`
+}
+
+type ExoscaleProvider struct {
+	// When using the Exoscale provider, the API environment (default: api)
+	// +optional
+	APIEnvironment *string `json:"apiEnvironment,omitempty"`
+
+	// When using the Exoscale provider, the zone of the API (default: ch-gva-2)
+	// +optional
+	APIZone *string `json:"apiZone,omitempty"`
+
+	// Provider secret holding the Exoscale API key, as apiKeyKey, and API secret, as apiSecretKey
+	SecretRef *APIKeySecretReference `json:"secretRef"`
+}
+
+type AlibabaCloudProvider struct {
+	// When using the Alibaba Cloud provider, only manage the zones of this type (default: all zones)
+	// +optional
+	// +kubebuilder:validation:Enum=public;private
+	ZoneType *string `json:"zoneType,omitempty"`
+
+	// Provider secret holding the Alibaba Cloud config file. When not set, the RAM role of the ECS instance
+	// running the operator is used.
+	// +optional
+	SecretRef *AlibabaCloudSecretReference `json:"secretRef,omitempty"`
+}
+
+type InMemoryProvider struct {
+	// When using the inmemory provider, the zones created in memory (default: the domain filter). The records are
+	// kept by the operator process, so they are lost when it restarts or when the zones or the domain filter change.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
 type ServiceConfig struct {
 	// Limit sources of endpoints to a specific namespace (default: all namespaces)
 	// +optional
@@ -242,6 +611,86 @@ type NodeConfig struct {
 	LabelFilter *string `json:"labelFilter,omitempty"`
 }
 
+type GatewayRouteConfig struct {
+	// Limit sources of endpoints to a specific namespace (default: all namespaces)
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set
+	// +optional
+	IgnoreHostnameAnnotation *bool `json:"ignoreHostnameAnnotation,omitempty"`
+
+	// Combine FQDN template and Annotations instead of overwriting
+	// +optional
+	CombineFQDNAndAnnotation *bool `json:"combineFQDNAndAnnotation,omitempty"`
+
+	// Filter sources managed by external-dns via label selector when listing all resources
+	// +optional
+	AnnotationFilter *string `json:"annotationFilter,omitempty"`
+
+	// Filter sources managed by external-dns via annotation using label selector semantics
+	// +optional
+	LabelFilter *string `json:"labelFilter,omitempty"`
+
+	// A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to
+	// add a hostname suffix when paired with the fake source
+	// +optional
+	FQDNTemplate *string `json:"fqdnTemplate,omitempty"`
+
+	// Limit Gateways of route endpoints to a specific name
+	// +optional
+	GatewayName *string `json:"gatewayName,omitempty"`
+}
+
+type IstioConfig struct {
+	// Limit sources of endpoints to a specific namespace (default: all namespaces)
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set
+	// +optional
+	IgnoreHostnameAnnotation *bool `json:"ignoreHostnameAnnotation,omitempty"`
+
+	// Combine FQDN template and Annotations instead of overwriting
+	// +optional
+	CombineFQDNAndAnnotation *bool `json:"combineFQDNAndAnnotation,omitempty"`
+
+	// Filter sources managed by external-dns via annotation using label selector semantics
+	// +optional
+	AnnotationFilter *string `json:"annotationFilter,omitempty"`
+
+	// Filter sources managed by external-dns via label selector when listing all resources
+	// +optional
+	LabelFilter *string `json:"labelFilter,omitempty"`
+
+	// A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to
+	// add a hostname suffix when paired with the fake source
+	// +optional
+	FQDNTemplate *string `json:"fqdnTemplate,omitempty"`
+}
+
+type CRDConfig struct {
+	// API version of the custom resource read by the crd source (default: externaldns.k8s.io/v1alpha1)
+	// +optional
+	APIVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind of the custom resource read by the crd source (default: DNSEndpoint)
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+
+	// Limit sources of endpoints to a specific namespace (default: all namespaces)
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Filter sources managed by external-dns via annotation using label selector semantics
+	// +optional
+	AnnotationFilter *string `json:"annotationFilter,omitempty"`
+
+	// Filter sources managed by external-dns via label selector when listing all resources
+	// +optional
+	LabelFilter *string `json:"labelFilter,omitempty"`
+}
+
 type SourceConfig struct {
 	// TypeInfo contains the source type of the external dns
 	// example:
@@ -264,6 +713,18 @@ type SourceConfig struct {
 	// For source type Ingress
 	// +optional
 	Ingress *IngressConfig `json:"ingress,omitempty"`
+
+	// For Gateway API source types HTTPRoute, GRPCRoute, TLSRoute, TCPRoute and UDPRoute
+	// +optional
+	GatewayRoute *GatewayRouteConfig `json:"gatewayRoute,omitempty"`
+
+	// For source types Gateway and VirtualService of group networking.istio.io
+	// +optional
+	Istio *IstioConfig `json:"istio,omitempty"`
+
+	// For source type DNSEndpoint, or any custom resource with the same schema
+	// +optional
+	CRD *CRDConfig `json:"crd,omitempty"`
 }
 
 // GenericSecretReference contains the information of the provider secret. Name is for secret name and CredentialKey is for specifying the key of the secret.
@@ -294,7 +755,191 @@ type CloudflareSecretReference struct {
 	APIEmailKey string `json:"apiEmailKey,omitempty"`
 }
 
+// RFC2136SecretReference contains the name of the secret holding the TSIG secret, the Kerberos credentials used
+// with GSS-TSIG, and the TLS certificates used to connect to the DNS server
+type RFC2136SecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the TSIG secret in the provider secret
+	// +optional
+	TSIGSecretKey string `json:"tsigSecretKey,omitempty"`
+
+	// key of the Kerberos username in the provider secret, used with GSS-TSIG
+	// +optional
+	KerberosUsernameKey string `json:"kerberosUsernameKey,omitempty"`
+
+	// key of the Kerberos password in the provider secret, used with GSS-TSIG
+	// +optional
+	KerberosPasswordKey string `json:"kerberosPasswordKey,omitempty"`
+
+	TLSSecretKeys `json:",inline"`
+}
+
+// PDNSSecretReference contains the name of the secret holding the API key and the TLS certificates of the
+// PowerDNS server
+type PDNSSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the API key in the provider secret
+	APIKeyKey string `json:"apiKeyKey"`
+
+	TLSSecretKeys `json:",inline"`
+}
+
+// CoreDNSSecretReference contains the name of the secret holding the basic auth credentials and the TLS
+// certificates of the etcd cluster
+type CoreDNSSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the etcd username in the provider secret
+	// +optional
+	UsernameKey string `json:"usernameKey,omitempty"`
+
+	// key of the etcd password in the provider secret
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+
+	TLSSecretKeys `json:",inline"`
+}
+
+// WebhookSecretReference contains the name of the secret holding the bearer token and the TLS certificates used to
+// call the webhook provider
+type WebhookSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the bearer token in the provider secret
+	// +optional
+	TokenKey string `json:"tokenKey,omitempty"`
+
+	TLSSecretKeys `json:",inline"`
+}
+
+// TokenSecretReference contains the name of the secret holding the API token of a provider
+type TokenSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the API token in the provider secret
+	TokenKey string `json:"tokenKey"`
+}
+
+// APIKeySecretReference contains the name of the secret holding the API key and the API secret of a provider
+type APIKeySecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the API key in the provider secret
+	APIKeyKey string `json:"apiKeyKey"`
+
+	// key of the API secret in the provider secret
+	APISecretKey string `json:"apiSecretKey"`
+}
+
+// AkamaiSecretReference contains the name of the secret holding the EdgeGrid credentials of the Akamai API. The
+// four tokens are used when they are all set, otherwise the .edgerc file is used.
+type AkamaiSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the service consumer domain, the host of the Akamai API, in the provider secret
+	// +optional
+	ServiceConsumerDomainKey string `json:"serviceConsumerDomainKey,omitempty"`
+
+	// key of the client token in the provider secret
+	// +optional
+	ClientTokenKey string `json:"clientTokenKey,omitempty"`
+
+	// key of the client secret in the provider secret
+	// +optional
+	ClientSecretKey string `json:"clientSecretKey,omitempty"`
+
+	// key of the access token in the provider secret
+	// +optional
+	AccessTokenKey string `json:"accessTokenKey,omitempty"`
+
+	// key of the .edgerc file in the provider secret
+	// +optional
+	EdgercKey string `json:"edgercKey,omitempty"`
+}
+
+// OCISecretReference contains the name of the secret holding the OCI config file, the oci.yaml of external-dns,
+// and the private key of the API signing key
+type OCISecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the OCI config file in the provider secret
+	ConfigKey string `json:"configKey"`
+
+	// key of the private key in the provider secret. It replaces the key of the config file when set.
+	// +optional
+	PrivateKeyKey string `json:"privateKeyKey,omitempty"`
+}
+
+// PiholeSecretReference contains the name of the secret holding the password of the Pi-hole web server
+type PiholeSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the password in the provider secret
+	PasswordKey string `json:"passwordKey"`
+}
+
+// OVHSecretReference contains the name of the secret holding the application credentials of the OVH API
+type OVHSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the application key in the provider secret
+	ApplicationKeyKey string `json:"applicationKeyKey"`
+
+	// key of the application secret in the provider secret
+	ApplicationSecretKey string `json:"applicationSecretKey"`
+
+	// key of the consumer key in the provider secret
+	ConsumerKeyKey string `json:"consumerKeyKey"`
+}
+
+// TransIPSecretReference contains the name of the secret holding the private key of the TransIP API
+type TransIPSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the private key in the provider secret
+	PrivateKeyKey string `json:"privateKeyKey"`
+}
+
+// AlibabaCloudSecretReference contains the name of the secret holding the Alibaba Cloud config file
+type AlibabaCloudSecretReference struct {
+	// Name of the provider secret
+	Name string `json:"name"`
+
+	// key of the config file in the provider secret. It is a JSON document with the regionId, accessKeyId,
+	// accessKeySecret and vpcId of the account.
+	ConfigKey string `json:"configKey"`
+}
+
+// TLSSecretKeys are the keys of the TLS certificates in a provider secret, used to connect to the DNS server
+type TLSSecretKeys struct {
+	// key of the CA certificate in the provider secret, used to verify the DNS server over TLS
+	// +optional
+	CAKey string `json:"caKey,omitempty"`
+
+	// key of the client certificate in the provider secret, used for mutual TLS
+	// +optional
+	ClientCertKey string `json:"clientCertKey,omitempty"`
+
+	// key of the client certificate key in the provider secret, used for mutual TLS
+	// +optional
+	ClientCertKeyKey string `json:"clientCertKeyKey,omitempty"`
+}
+
 // ExternalDNSSpec defines the desired state of ExternalDNS
+// +kubebuilder:validation:XValidation:rule="!has(self.deletionPolicy) || self.deletionPolicy != 'Orphan' || !has(self.registry) || self.registry.type in ['txt', 'noop']",message="deletionPolicy Orphan requires the txt or noop registry"
 type ExternalDNSSpec struct {
 	// Request timeout when calling Kubernetes API. 0s means no timeout
 	// +optional
@@ -306,7 +951,14 @@ type ExternalDNSSpec struct {
 	//    group: ""
 	//    version: v1
 	//    kind: Service
-	Source SourceConfig `json:"source"`
+	// Use sources to read endpoints from more than one kind
+	// +optional
+	Source SourceConfig `json:"source,omitempty"`
+
+	// List of sources whose endpoints are merged and published together under the same owner.
+	// It is combined with source, when both are set.
+	// +optional
+	Sources []SourceConfig `json:"sources,omitempty"`
 
 	// If source is openshift router then you can pass the ingress controller name. Based on this name the
 	// external dns will select the respective router from the route status and map that routeCanonicalHostname
@@ -352,7 +1004,7 @@ type ExternalDNSSpec struct {
 	// +optional
 	ZoneIDFilter []string `json:"zoneIDFilter,omitempty"`
 
-	// AWS provider information
+	// AWS provider information, used by the aws and aws-sd providers
 	// +optional
 	AWS *AWSProvider `json:"aws,omitempty"`
 
@@ -368,6 +1020,110 @@ type ExternalDNSSpec struct {
 	// +optional
 	Google *GoogleProvider `json:"google,omitempty"`
 
+	// RFC2136 provider information
+	// +optional
+	RFC2136 *RFC2136Provider `json:"rfc2136,omitempty"`
+
+	// PowerDNS provider information
+	// +optional
+	PDNS *PDNSProvider `json:"pdns,omitempty"`
+
+	// CoreDNS provider information
+	// +optional
+	CoreDNS *CoreDNSProvider `json:"coredns,omitempty"`
+
+	// Webhook provider information
+	// +optional
+	Webhook *WebhookProvider `json:"webhook,omitempty"`
+
+	// DigitalOcean provider information
+	// +optional
+	DigitalOcean *DigitalOceanProvider `json:"digitalocean,omitempty"`
+
+	// Linode provider information
+	// +optional
+	Linode *LinodeProvider `json:"linode,omitempty"`
+
+	// Scaleway provider information
+	// +optional
+	Scaleway *ScalewayProvider `json:"scaleway,omitempty"`
+
+	// Civo provider information
+	// +optional
+	Civo *CivoProvider `json:"civo,omitempty"`
+
+	// DNSimple provider information
+	// +optional
+	DNSimple *DNSimpleProvider `json:"dnsimple,omitempty"`
+
+	// Gandi provider information
+	// +optional
+	Gandi *GandiProvider `json:"gandi,omitempty"`
+
+	// GoDaddy provider information
+	// +optional
+	GoDaddy *GoDaddyProvider `json:"godaddy,omitempty"`
+
+	// Akamai provider information
+	// +optional
+	Akamai *AkamaiProvider `json:"akamai,omitempty"`
+
+	// OCI provider information
+	// +optional
+	OCI *OCIProvider `json:"oci,omitempty"`
+
+	// Pi-hole provider information. Pi-hole cannot store ownership records, so the noop registry is always used
+	// with it.
+	// +optional
+	Pihole *PiholeProvider `json:"pihole,omitempty"`
+
+	// NS1 provider information
+	// +optional
+	NS1 *NS1Provider `json:"ns1,omitempty"`
+
+	// OVH provider information
+	// +optional
+	OVH *OVHProvider `json:"ovh,omitempty"`
+
+	// TransIP provider information
+	// +optional
+	TransIP *TransIPProvider `json:"transip,omitempty"`
+
+	// Exoscale provider information
+	// +optional
+	Exoscale *ExoscaleProvider `json:"exoscale,omitempty"`
+
+	// Alibaba Cloud provider information
+	// +optional
+	AlibabaCloud *AlibabaCloudProvider `json:"alibabacloud,omitempty"`
+
+	// InMemory provider information, for local development and tests. Its records are shown in
+	// status.inMemoryRecords.
+	// +optional
+	InMemory *InMemoryProvider `json:"inmemory,omitempty"`
+
+	// When enabled, the plan is computed and published in status.pendingChanges, but not applied. A deleted
+	// ExternalDNS keeps its finalizer and publishes the records it would delete until the dry run is disabled
+	// or the deletion policy is Retain
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Approval decides which plans are applied only after they are approved, by annotating the ExternalDNS with
+	// external-dns.appscode.com/approve-plan set to status.planHash (default: Automatic, options: Automatic,
+	// Manual, DeletesOnly). With DeletesOnly, only plans deleting records need approval. The annotation is removed
+	// once its plan is applied
+	// +optional
+	Approval *ApprovalPolicy `json:"approval,omitempty"`
+
+	// Safety limits what a single sync is allowed to change
+	// +optional
+	Safety *SafetyConfig `json:"safety,omitempty"`
+
+	// Interval for periodic synchronization of the DNS records, so changes made outside of the operator
+	// are corrected, e.g. 5m (default: 1m). 0 disables the periodic synchronization
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
 	//
 	//POLICY INFORMATION
 	//
@@ -375,12 +1131,19 @@ type ExternalDNSSpec struct {
 	// +optional
 	Policy *Policy `json:"policy,omitempty"`
 
+	// DeletionPolicy decides what happens to the DNS records when the ExternalDNS is deleted (options: Delete,
+	// Retain, Orphan). Retain keeps the records and their ownership records, Orphan keeps the records but deletes
+	// their ownership records so another owner can adopt them. Defaults to Delete when the policy is sync and
+	// Retain otherwise. Orphan requires the txt or noop registry
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	//
 	// REGISTRY information
 	//
-	// The registry implementation to use to keep track of DNS record ownership (default: txt, options: txt, noop, aws-sd)
+	// The registry to use to keep track of DNS record ownership (default: the txt registry)
 	// +optional
-	Registry *string `json:"registry,omitempty"`
+	Registry *Registry `json:"registry,omitempty"`
 
 	// When using the TXT registry, a name that identifies this instance of ExternalDNS (default: default)
 	// +optional
@@ -402,6 +1165,70 @@ type ExternalDNSSpec struct {
 	TXTWildcardReplacement *string `json:"txtWildcardReplacement,omitempty"`
 }
 
+// Registry keeps track of the ownership of the DNS records, with the settings of its type
+// +kubebuilder:validation:XValidation:rule="!has(self.txt) || self.type == 'txt'",message="txt requires the txt registry type"
+// +kubebuilder:validation:XValidation:rule="!has(self.dynamodb) || self.type == 'dynamodb'",message="dynamodb requires the dynamodb registry type"
+type Registry struct {
+	// Type of the registry (options: txt, noop, aws-sd, dynamodb). The aws-sd provider only works with the aws-sd,
+	// noop and dynamodb registries, the pihole provider only with noop and the dynamodb registry only with the aws
+	// and aws-sd providers, whose credentials it shares.
+	Type RegistryType `json:"type"`
+
+	// TXT registry information
+	// +optional
+	TXT *TXTRegistry `json:"txt,omitempty"`
+
+	// When using the DynamoDB registry, the table the ownership records are kept in
+	// +optional
+	DynamoDB *DynamoDBRegistry `json:"dynamodb,omitempty"`
+}
+
+type TXTRegistry struct {
+	// When using the TXT registry, encrypt the ownership records, so they do not disclose the owner and the
+	// resources of the records
+	// +optional
+	Encryption *TXTEncryption `json:"encryption,omitempty"`
+}
+
+type TXTEncryption struct {
+	// secret holding the AES-256 key the ownership records are encrypted with
+	SecretRef TXTEncryptionSecretReference `json:"secretRef"`
+}
+
+// TXTEncryptionSecretReference contains the name of the secret holding the AES keys of the TXT registry. The keys
+// are 32 bytes long, in plain text or base64-encoded.
+type TXTEncryptionSecretReference struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// key of the AES key in the secret, the ownership records are encrypted with
+	AESKeyKey string `json:"aesKeyKey"`
+
+	// key of the previous AES key in the secret, while rotating the key. The ownership records encrypted with the
+	// previous key are encrypted again with the new one, after which the previous key can be removed.
+	// +optional
+	OldAESKeyKey *string `json:"oldAESKeyKey,omitempty"`
+}
+
+type DynamoDBRegistry struct {
+	// When using the DynamoDB registry, the name of the table (default: external-dns)
+	// +optional
+	Table *string `json:"table,omitempty"`
+
+	// When using the DynamoDB registry, the AWS region of the table (default: the region of the AWS credentials)
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// When using the DynamoDB registry, the URL of the DynamoDB API, to use DynamoDB Local or a compatible service
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// When using the DynamoDB registry, create the table with the key schema of the registry when it does not exist.
+	// The AWS credentials need the dynamodb:CreateTable permission.
+	// +optional
+	CreateTable *bool `json:"createTable,omitempty"`
+}
+
 // DNSRecord hold the DNS name and target address, if there are multiple target address then the addresses are joint by separator ';' between them (ex: 1:2:3:4;6:7:8:9)
 type DNSRecord struct {
 	// target is the list of target address
@@ -411,6 +1238,97 @@ type DNSRecord struct {
 	// dns name is the domain name for this record
 	// +optional
 	Name string `json:"name,omitempty"`
+
+	// CloudMap identifies the AWS Cloud Map service of the record, when using the aws-sd provider
+	// +optional
+	CloudMap *CloudMapRecord `json:"cloudMap,omitempty"`
+}
+
+// InMemoryRecord is a record held by the inmemory provider
+type InMemoryRecord struct {
+	// Name is the domain name of the record
+	Name string `json:"name"`
+
+	// Type is the type of the record (ex: A, CNAME, TXT)
+	Type string `json:"type"`
+
+	// Targets are the targets of the record
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
+// CloudMapRecord identifies the AWS Cloud Map namespace and service a DNS record is registered in
+type CloudMapRecord struct {
+	// NamespaceID is the id of the Cloud Map namespace
+	NamespaceID string `json:"namespaceID"`
+
+	// ServiceID is the id of the Cloud Map service
+	ServiceID string `json:"serviceID"`
+}
+
+type SafetyConfig struct {
+	// Maximum number of records a single sync may delete, as an absolute number (ex: 10) or a percentage of
+	// the records currently owned by this instance (ex: 25%). A plan deleting more is not applied until it is
+	// approved with the external-dns.appscode.com/approve-plan annotation. The limit also guards the deletion
+	// of the records when the ExternalDNS is deleted, unless it has the annotation
+	// external-dns.appscode.com/override-delete-safety: "true"
+	// +optional
+	MaxDeletesPerSync *intstr.IntOrString `json:"maxDeletesPerSync,omitempty"`
+}
+
+// DNSChange is a DNS record created, updated or deleted by a plan
+type DNSChange struct {
+	// Name is the domain name of the record
+	Name string `json:"name"`
+
+	// Type is the record type (ex: A, CNAME, TXT)
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Targets of the record
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// TTL of the record in seconds
+	// +optional
+	TTL int64 `json:"ttl,omitempty"`
+
+	// SetIdentifier distinguishes records with the same name and type (ex: weighted or latency routing)
+	// +optional
+	SetIdentifier string `json:"setIdentifier,omitempty"`
+
+	// ProviderSpecific are the provider specific properties of the record
+	// +optional
+	ProviderSpecific []ProviderSpecificProperty `json:"providerSpecific,omitempty"`
+}
+
+// ProviderSpecificProperty is a provider specific property of a DNS record
+type ProviderSpecificProperty struct {
+	// Name of the property
+	Name string `json:"name"`
+
+	// Value of the property
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// PendingChanges are the changes of a computed plan that are not applied yet
+type PendingChanges struct {
+	// Records that need to be created
+	// +optional
+	Create []DNSChange `json:"create,omitempty"`
+
+	// Records that need to be updated (current data)
+	// +optional
+	UpdateOld []DNSChange `json:"updateOld,omitempty"`
+
+	// Records that need to be updated (desired data)
+	// +optional
+	UpdateNew []DNSChange `json:"updateNew,omitempty"`
+
+	// Records that need to be deleted
+	// +optional
+	Delete []DNSChange `json:"delete,omitempty"`
 }
 
 // ExternalDNSStatus defines the observed state of ExternalDNS
@@ -430,6 +1348,26 @@ type ExternalDNSStatus struct {
 	// DNSRecord is the list of records that this external dns operator registered
 	// +optional
 	DNSRecords []DNSRecord `json:"dnsRecords,omitempty"`
+
+	// LastSyncTime is the time the DNS records were last synchronized with the provider
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// NextSyncTime is the time of the next periodic synchronization
+	// +optional
+	NextSyncTime *metav1.Time `json:"nextSyncTime,omitempty"`
+
+	// PendingChanges are the changes computed in dry run mode or waiting for approval, that are not applied to the provider
+	// +optional
+	PendingChanges *PendingChanges `json:"pendingChanges,omitempty"`
+
+	// PlanHash is the content hash of the pending changes, used to approve them
+	// +optional
+	PlanHash string `json:"planHash,omitempty"`
+
+	// InMemoryRecords are the records held by the inmemory provider, including the ownership records of the registry
+	// +optional
+	InMemoryRecords []InMemoryRecord `json:"inMemoryRecords,omitempty"`
 }
 
 // +genclient
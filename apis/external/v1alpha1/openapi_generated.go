@@ -35,373 +35,421 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/api/apps/v1.ControllerRevision":                                              schema_k8sio_api_apps_v1_ControllerRevision(ref),
-		"k8s.io/api/apps/v1.ControllerRevisionList":                                          schema_k8sio_api_apps_v1_ControllerRevisionList(ref),
-		"k8s.io/api/apps/v1.DaemonSet":                                                       schema_k8sio_api_apps_v1_DaemonSet(ref),
-		"k8s.io/api/apps/v1.DaemonSetCondition":                                              schema_k8sio_api_apps_v1_DaemonSetCondition(ref),
-		"k8s.io/api/apps/v1.DaemonSetList":                                                   schema_k8sio_api_apps_v1_DaemonSetList(ref),
-		"k8s.io/api/apps/v1.DaemonSetSpec":                                                   schema_k8sio_api_apps_v1_DaemonSetSpec(ref),
-		"k8s.io/api/apps/v1.DaemonSetStatus":                                                 schema_k8sio_api_apps_v1_DaemonSetStatus(ref),
-		"k8s.io/api/apps/v1.DaemonSetUpdateStrategy":                                         schema_k8sio_api_apps_v1_DaemonSetUpdateStrategy(ref),
-		"k8s.io/api/apps/v1.Deployment":                                                      schema_k8sio_api_apps_v1_Deployment(ref),
-		"k8s.io/api/apps/v1.DeploymentCondition":                                             schema_k8sio_api_apps_v1_DeploymentCondition(ref),
-		"k8s.io/api/apps/v1.DeploymentList":                                                  schema_k8sio_api_apps_v1_DeploymentList(ref),
-		"k8s.io/api/apps/v1.DeploymentSpec":                                                  schema_k8sio_api_apps_v1_DeploymentSpec(ref),
-		"k8s.io/api/apps/v1.DeploymentStatus":                                                schema_k8sio_api_apps_v1_DeploymentStatus(ref),
-		"k8s.io/api/apps/v1.DeploymentStrategy":                                              schema_k8sio_api_apps_v1_DeploymentStrategy(ref),
-		"k8s.io/api/apps/v1.ReplicaSet":                                                      schema_k8sio_api_apps_v1_ReplicaSet(ref),
-		"k8s.io/api/apps/v1.ReplicaSetCondition":                                             schema_k8sio_api_apps_v1_ReplicaSetCondition(ref),
-		"k8s.io/api/apps/v1.ReplicaSetList":                                                  schema_k8sio_api_apps_v1_ReplicaSetList(ref),
-		"k8s.io/api/apps/v1.ReplicaSetSpec":                                                  schema_k8sio_api_apps_v1_ReplicaSetSpec(ref),
-		"k8s.io/api/apps/v1.ReplicaSetStatus":                                                schema_k8sio_api_apps_v1_ReplicaSetStatus(ref),
-		"k8s.io/api/apps/v1.RollingUpdateDaemonSet":                                          schema_k8sio_api_apps_v1_RollingUpdateDaemonSet(ref),
-		"k8s.io/api/apps/v1.RollingUpdateDeployment":                                         schema_k8sio_api_apps_v1_RollingUpdateDeployment(ref),
-		"k8s.io/api/apps/v1.RollingUpdateStatefulSetStrategy":                                schema_k8sio_api_apps_v1_RollingUpdateStatefulSetStrategy(ref),
-		"k8s.io/api/apps/v1.StatefulSet":                                                     schema_k8sio_api_apps_v1_StatefulSet(ref),
-		"k8s.io/api/apps/v1.StatefulSetCondition":                                            schema_k8sio_api_apps_v1_StatefulSetCondition(ref),
-		"k8s.io/api/apps/v1.StatefulSetList":                                                 schema_k8sio_api_apps_v1_StatefulSetList(ref),
-		"k8s.io/api/apps/v1.StatefulSetOrdinals":                                             schema_k8sio_api_apps_v1_StatefulSetOrdinals(ref),
-		"k8s.io/api/apps/v1.StatefulSetPersistentVolumeClaimRetentionPolicy":                 schema_k8sio_api_apps_v1_StatefulSetPersistentVolumeClaimRetentionPolicy(ref),
-		"k8s.io/api/apps/v1.StatefulSetSpec":                                                 schema_k8sio_api_apps_v1_StatefulSetSpec(ref),
-		"k8s.io/api/apps/v1.StatefulSetStatus":                                               schema_k8sio_api_apps_v1_StatefulSetStatus(ref),
-		"k8s.io/api/apps/v1.StatefulSetUpdateStrategy":                                       schema_k8sio_api_apps_v1_StatefulSetUpdateStrategy(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                        schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AppArmorProfile":                                                 schema_k8sio_api_core_v1_AppArmorProfile(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                  schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                       schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                           schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                 schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                           schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                         schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                 schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                    schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                              schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                              schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                  schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                    schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                              schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                 schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                             schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                       schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                              schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                            schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                   schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                       schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                             schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                           schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                       schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                  schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                   schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerResizePolicy":                                           schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                  schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                           schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                        schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                           schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                 schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.ContainerUser":                                                   schema_k8sio_api_core_v1_ContainerUser(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                  schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                           schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                         schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                            schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                 schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                    schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                  schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                       schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                   schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                   schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                          schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                    schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.EphemeralContainer":                                              schema_k8sio_api_core_v1_EphemeralContainer(ref),
-		"k8s.io/api/core/v1.EphemeralContainerCommon":                                        schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
-		"k8s.io/api/core/v1.EphemeralVolumeSource":                                           schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
-		"k8s.io/api/core/v1.Event":                                                           schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                       schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                     schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                     schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                      schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                  schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                      schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                             schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GRPCAction":                                                      schema_k8sio_api_core_v1_GRPCAction(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                             schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                 schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                           schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                   schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                      schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                       schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostIP":                                                          schema_k8sio_api_core_v1_HostIP(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                            schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                               schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.ImageVolumeSource":                                               schema_k8sio_api_core_v1_ImageVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                       schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                       schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LifecycleHandler":                                                schema_k8sio_api_core_v1_LifecycleHandler(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                      schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                  schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                  schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                  schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.LinuxContainerUser":                                              schema_k8sio_api_core_v1_LinuxContainerUser(ref),
-		"k8s.io/api/core/v1.List":                                                            schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                             schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                              schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                            schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                               schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.ModifyVolumeStatus":                                              schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                 schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                       schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceCondition":                                              schema_k8sio_api_core_v1_NamespaceCondition(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                   schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                   schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                 schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                            schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                     schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                    schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                   schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                             schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeFeatures":                                                    schema_k8sio_api_core_v1_NodeFeatures(ref),
-		"k8s.io/api/core/v1.NodeList":                                                        schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandler":                                              schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                      schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                    schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                         schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                        schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                      schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSwapStatus":                                                  schema_k8sio_api_core_v1_NodeSwapStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                  schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                             schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                 schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                           schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                   schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                               schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                            schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                          schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                            schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                          schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                             schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                     schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                 schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                 schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                    schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                    schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                              schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                  schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodIP":                                                           schema_k8sio_api_core_v1_PodIP(ref),
-		"k8s.io/api/core/v1.PodList":                                                         schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                   schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodOS":                                                           schema_k8sio_api_core_v1_PodOS(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                           schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                 schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodResourceClaim":                                                schema_k8sio_api_core_v1_PodResourceClaim(ref),
-		"k8s.io/api/core/v1.PodResourceClaimStatus":                                          schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodSchedulingGate":                                               schema_k8sio_api_core_v1_PodSchedulingGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                              schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                    schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                         schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                       schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                 schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                     schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                 schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                 schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortStatus":                                                      schema_k8sio_api_core_v1_PortStatus(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                            schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                            schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                         schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                           schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProbeHandler":                                                    schema_k8sio_api_core_v1_ProbeHandler(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                           schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                             schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                       schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                 schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                 schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                           schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                  schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                       schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                       schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                     schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceClaim":                                                   schema_k8sio_api_core_v1_ResourceClaim(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                           schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceHealth":                                                  schema_k8sio_api_core_v1_ResourceHealth(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                   schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                               schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                               schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                             schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                            schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.ResourceStatus":                                                  schema_k8sio_api_core_v1_ResourceStatus(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                  schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                   schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                             schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                   schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                               schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.SeccompProfile":                                                  schema_k8sio_api_core_v1_SeccompProfile(ref),
-		"k8s.io/api/core/v1.Secret":                                                          schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                 schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                               schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                      schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                 schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                              schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                 schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                             schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                         schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                  schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                              schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                   schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                     schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                     schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                             schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                     schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                   schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                           schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.SleepAction":                                                     schema_k8sio_api_core_v1_SleepAction(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                 schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                           schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                          schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                 schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                           schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                      schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                            schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TopologySpreadConstraint":                                        schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                       schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.TypedObjectReference":                                            schema_k8sio_api_core_v1_TypedObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                          schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                    schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                     schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeMountStatus":                                               schema_k8sio_api_core_v1_VolumeMountStatus(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                              schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeResourceRequirements":                                      schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                    schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                  schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                         schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                   schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                 schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                     schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                              schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                          schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                      schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                            schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                     schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                 schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                        schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                         schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                         schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                      schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                   schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                  schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                     schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                      schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                      schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                      schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                          schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                         schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                     schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                         schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                  schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                        schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                         schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                         schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                  schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                      schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                             schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                          schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                    schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                               schema_k8sio_apimachinery_pkg_version_Info(ref),
-		"kmodules.xyz/client-go/api/v1.CAPIClusterInfo":                                      schema_kmodulesxyz_client_go_api_v1_CAPIClusterInfo(ref),
-		"kmodules.xyz/client-go/api/v1.CertificatePrivateKey":                                schema_kmodulesxyz_client_go_api_v1_CertificatePrivateKey(ref),
-		"kmodules.xyz/client-go/api/v1.CertificateSpec":                                      schema_kmodulesxyz_client_go_api_v1_CertificateSpec(ref),
-		"kmodules.xyz/client-go/api/v1.ClusterClaimFeatures":                                 schema_kmodulesxyz_client_go_api_v1_ClusterClaimFeatures(ref),
-		"kmodules.xyz/client-go/api/v1.ClusterClaimInfo":                                     schema_kmodulesxyz_client_go_api_v1_ClusterClaimInfo(ref),
-		"kmodules.xyz/client-go/api/v1.ClusterInfo":                                          schema_kmodulesxyz_client_go_api_v1_ClusterInfo(ref),
-		"kmodules.xyz/client-go/api/v1.ClusterMetadata":                                      schema_kmodulesxyz_client_go_api_v1_ClusterMetadata(ref),
-		"kmodules.xyz/client-go/api/v1.Condition":                                            schema_kmodulesxyz_client_go_api_v1_Condition(ref),
-		"kmodules.xyz/client-go/api/v1.HealthCheckSpec":                                      schema_kmodulesxyz_client_go_api_v1_HealthCheckSpec(ref),
-		"kmodules.xyz/client-go/api/v1.ImageInfo":                                            schema_kmodulesxyz_client_go_api_v1_ImageInfo(ref),
-		"kmodules.xyz/client-go/api/v1.Lineage":                                              schema_kmodulesxyz_client_go_api_v1_Lineage(ref),
-		"kmodules.xyz/client-go/api/v1.ObjectID":                                             schema_kmodulesxyz_client_go_api_v1_ObjectID(ref),
-		"kmodules.xyz/client-go/api/v1.ObjectInfo":                                           schema_kmodulesxyz_client_go_api_v1_ObjectInfo(ref),
-		"kmodules.xyz/client-go/api/v1.ObjectReference":                                      schema_kmodulesxyz_client_go_api_v1_ObjectReference(ref),
-		"kmodules.xyz/client-go/api/v1.PullCredentials":                                      schema_kmodulesxyz_client_go_api_v1_PullCredentials(ref),
-		"kmodules.xyz/client-go/api/v1.ReadonlyHealthCheckSpec":                              schema_kmodulesxyz_client_go_api_v1_ReadonlyHealthCheckSpec(ref),
-		"kmodules.xyz/client-go/api/v1.ResourceID":                                           schema_kmodulesxyz_client_go_api_v1_ResourceID(ref),
-		"kmodules.xyz/client-go/api/v1.TLSConfig":                                            schema_kmodulesxyz_client_go_api_v1_TLSConfig(ref),
-		"kmodules.xyz/client-go/api/v1.TimeOfDay":                                            schema_kmodulesxyz_client_go_api_v1_TimeOfDay(ref),
-		"kmodules.xyz/client-go/api/v1.TypeReference":                                        schema_kmodulesxyz_client_go_api_v1_TypeReference(ref),
-		"kmodules.xyz/client-go/api/v1.TypedObjectReference":                                 schema_kmodulesxyz_client_go_api_v1_TypedObjectReference(ref),
-		"kmodules.xyz/client-go/api/v1.X509Subject":                                          schema_kmodulesxyz_client_go_api_v1_X509Subject(ref),
-		"kmodules.xyz/client-go/api/v1.stringSetMerger":                                      schema_kmodulesxyz_client_go_api_v1_stringSetMerger(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider":               schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider":             schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider":        schema_external_dns_operator_apis_external_v1alpha1_CloudflareProvider(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareSecretReference": schema_external_dns_operator_apis_external_v1alpha1_CloudflareSecretReference(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord":                 schema_external_dns_operator_apis_external_v1alpha1_DNSRecord(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS":               schema_external_dns_operator_apis_external_v1alpha1_ExternalDNS(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSList":           schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSList(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec":           schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus":         schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSStatus(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference":    schema_external_dns_operator_apis_external_v1alpha1_GenericSecretReference(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider":            schema_external_dns_operator_apis_external_v1alpha1_GoogleProvider(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig":             schema_external_dns_operator_apis_external_v1alpha1_IngressConfig(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig":                schema_external_dns_operator_apis_external_v1alpha1_NodeConfig(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig":             schema_external_dns_operator_apis_external_v1alpha1_ServiceConfig(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig":              schema_external_dns_operator_apis_external_v1alpha1_SourceConfig(ref),
-		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo":                  schema_external_dns_operator_apis_external_v1alpha1_TypeInfo(ref),
+		"k8s.io/api/apps/v1.ControllerRevision":                                                 schema_k8sio_api_apps_v1_ControllerRevision(ref),
+		"k8s.io/api/apps/v1.ControllerRevisionList":                                             schema_k8sio_api_apps_v1_ControllerRevisionList(ref),
+		"k8s.io/api/apps/v1.DaemonSet":                                                          schema_k8sio_api_apps_v1_DaemonSet(ref),
+		"k8s.io/api/apps/v1.DaemonSetCondition":                                                 schema_k8sio_api_apps_v1_DaemonSetCondition(ref),
+		"k8s.io/api/apps/v1.DaemonSetList":                                                      schema_k8sio_api_apps_v1_DaemonSetList(ref),
+		"k8s.io/api/apps/v1.DaemonSetSpec":                                                      schema_k8sio_api_apps_v1_DaemonSetSpec(ref),
+		"k8s.io/api/apps/v1.DaemonSetStatus":                                                    schema_k8sio_api_apps_v1_DaemonSetStatus(ref),
+		"k8s.io/api/apps/v1.DaemonSetUpdateStrategy":                                            schema_k8sio_api_apps_v1_DaemonSetUpdateStrategy(ref),
+		"k8s.io/api/apps/v1.Deployment":                                                         schema_k8sio_api_apps_v1_Deployment(ref),
+		"k8s.io/api/apps/v1.DeploymentCondition":                                                schema_k8sio_api_apps_v1_DeploymentCondition(ref),
+		"k8s.io/api/apps/v1.DeploymentList":                                                     schema_k8sio_api_apps_v1_DeploymentList(ref),
+		"k8s.io/api/apps/v1.DeploymentSpec":                                                     schema_k8sio_api_apps_v1_DeploymentSpec(ref),
+		"k8s.io/api/apps/v1.DeploymentStatus":                                                   schema_k8sio_api_apps_v1_DeploymentStatus(ref),
+		"k8s.io/api/apps/v1.DeploymentStrategy":                                                 schema_k8sio_api_apps_v1_DeploymentStrategy(ref),
+		"k8s.io/api/apps/v1.ReplicaSet":                                                         schema_k8sio_api_apps_v1_ReplicaSet(ref),
+		"k8s.io/api/apps/v1.ReplicaSetCondition":                                                schema_k8sio_api_apps_v1_ReplicaSetCondition(ref),
+		"k8s.io/api/apps/v1.ReplicaSetList":                                                     schema_k8sio_api_apps_v1_ReplicaSetList(ref),
+		"k8s.io/api/apps/v1.ReplicaSetSpec":                                                     schema_k8sio_api_apps_v1_ReplicaSetSpec(ref),
+		"k8s.io/api/apps/v1.ReplicaSetStatus":                                                   schema_k8sio_api_apps_v1_ReplicaSetStatus(ref),
+		"k8s.io/api/apps/v1.RollingUpdateDaemonSet":                                             schema_k8sio_api_apps_v1_RollingUpdateDaemonSet(ref),
+		"k8s.io/api/apps/v1.RollingUpdateDeployment":                                            schema_k8sio_api_apps_v1_RollingUpdateDeployment(ref),
+		"k8s.io/api/apps/v1.RollingUpdateStatefulSetStrategy":                                   schema_k8sio_api_apps_v1_RollingUpdateStatefulSetStrategy(ref),
+		"k8s.io/api/apps/v1.StatefulSet":                                                        schema_k8sio_api_apps_v1_StatefulSet(ref),
+		"k8s.io/api/apps/v1.StatefulSetCondition":                                               schema_k8sio_api_apps_v1_StatefulSetCondition(ref),
+		"k8s.io/api/apps/v1.StatefulSetList":                                                    schema_k8sio_api_apps_v1_StatefulSetList(ref),
+		"k8s.io/api/apps/v1.StatefulSetOrdinals":                                                schema_k8sio_api_apps_v1_StatefulSetOrdinals(ref),
+		"k8s.io/api/apps/v1.StatefulSetPersistentVolumeClaimRetentionPolicy":                    schema_k8sio_api_apps_v1_StatefulSetPersistentVolumeClaimRetentionPolicy(ref),
+		"k8s.io/api/apps/v1.StatefulSetSpec":                                                    schema_k8sio_api_apps_v1_StatefulSetSpec(ref),
+		"k8s.io/api/apps/v1.StatefulSetStatus":                                                  schema_k8sio_api_apps_v1_StatefulSetStatus(ref),
+		"k8s.io/api/apps/v1.StatefulSetUpdateStrategy":                                          schema_k8sio_api_apps_v1_StatefulSetUpdateStrategy(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                   schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                           schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AppArmorProfile":                                                    schema_k8sio_api_core_v1_AppArmorProfile(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                     schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                          schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                              schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                    schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                              schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                            schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                          schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                    schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                       schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                 schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                 schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                     schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                       schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                 schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                    schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                          schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                 schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                               schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                      schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                          schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                              schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                          schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                     schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                      schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerResizePolicy":                                              schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                     schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                              schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                           schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                              schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                    schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.ContainerUser":                                                      schema_k8sio_api_core_v1_ContainerUser(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                     schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                              schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                              schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                            schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                               schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                    schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                       schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                     schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                          schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                      schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                      schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                             schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                       schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.EphemeralContainer":                                                 schema_k8sio_api_core_v1_EphemeralContainer(ref),
+		"k8s.io/api/core/v1.EphemeralContainerCommon":                                           schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
+		"k8s.io/api/core/v1.EphemeralVolumeSource":                                              schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
+		"k8s.io/api/core/v1.Event":                                                              schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                          schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                        schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                        schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                         schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                     schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                         schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                   schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                      schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GRPCAction":                                                         schema_k8sio_api_core_v1_GRPCAction(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                    schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                              schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                      schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                         schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                          schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostIP":                                                             schema_k8sio_api_core_v1_HostIP(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                               schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                        schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                  schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.ImageVolumeSource":                                                  schema_k8sio_api_core_v1_ImageVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                          schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                          schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LifecycleHandler":                                                   schema_k8sio_api_core_v1_LifecycleHandler(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                         schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                     schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                     schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                     schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.LinuxContainerUser":                                                 schema_k8sio_api_core_v1_LinuxContainerUser(ref),
+		"k8s.io/api/core/v1.List":                                                               schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                 schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                               schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                  schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.ModifyVolumeStatus":                                                 schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                    schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                          schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceCondition":                                                 schema_k8sio_api_core_v1_NamespaceCondition(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                      schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                      schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                    schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                               schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                        schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                       schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                      schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                   schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                   schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeFeatures":                                                       schema_k8sio_api_core_v1_NodeFeatures(ref),
+		"k8s.io/api/core/v1.NodeList":                                                           schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                   schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandler":                                                 schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                         schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                       schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                            schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                   schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                           schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                         schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSwapStatus":                                                     schema_k8sio_api_core_v1_NodeSwapStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                     schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                    schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                   schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                              schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                          schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                          schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                        schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                      schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                               schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                             schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                               schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                             schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                        schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                    schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                    schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                   schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                       schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                       schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                 schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                     schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodIP":                                                              schema_k8sio_api_core_v1_PodIP(ref),
+		"k8s.io/api/core/v1.PodList":                                                            schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                      schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodOS":                                                              schema_k8sio_api_core_v1_PodOS(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                              schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                    schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                   schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodResourceClaim":                                                   schema_k8sio_api_core_v1_PodResourceClaim(ref),
+		"k8s.io/api/core/v1.PodResourceClaimStatus":                                             schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodSchedulingGate":                                                  schema_k8sio_api_core_v1_PodSchedulingGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                 schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                       schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                            schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                          schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                    schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                        schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                    schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                    schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortStatus":                                                         schema_k8sio_api_core_v1_PortStatus(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                               schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                               schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                            schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                              schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProbeHandler":                                                       schema_k8sio_api_core_v1_ProbeHandler(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                              schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                          schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                    schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                    schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                              schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                     schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                          schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                          schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                        schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceClaim":                                                      schema_k8sio_api_core_v1_ResourceClaim(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                              schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceHealth":                                                     schema_k8sio_api_core_v1_ResourceHealth(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                      schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                  schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                  schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                               schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.ResourceStatus":                                                     schema_k8sio_api_core_v1_ResourceStatus(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                     schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                      schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                      schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                  schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.SeccompProfile":                                                     schema_k8sio_api_core_v1_SeccompProfile(ref),
+		"k8s.io/api/core/v1.Secret":                                                             schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                    schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                  schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                         schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                   schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                    schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                 schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                    schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                            schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                     schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                 schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                      schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                        schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                        schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                        schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                      schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                              schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.SleepAction":                                                        schema_k8sio_api_core_v1_SleepAction(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                              schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                             schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                    schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                              schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                         schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                   schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                               schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TopologySpreadConstraint":                                           schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                          schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.TypedObjectReference":                                               schema_k8sio_api_core_v1_TypedObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                             schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                       schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                        schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeMountStatus":                                                  schema_k8sio_api_core_v1_VolumeMountStatus(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                 schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                   schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeResourceRequirements":                                         schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                       schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                     schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                            schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                      schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                    schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                        schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                 schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                             schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                    schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                         schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                               schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                        schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                    schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                           schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                            schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                            schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                         schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                      schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                         schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                     schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                      schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                  schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                      schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                     schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                        schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                    schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                    schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                         schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                         schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                         schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                       schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                        schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                    schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                     schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                         schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                 schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                             schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                    schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                    schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                         schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                             schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                         schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                      schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                               schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                        schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                       schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                   schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                            schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                        schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                            schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                     schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                    schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                        schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                        schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                           schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                      schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                    schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                            schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                            schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                     schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                         schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                             schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                        schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                         schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                    schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                       schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                          schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                              schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                               schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                       schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                  schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"kmodules.xyz/client-go/api/v1.CAPIClusterInfo":                                         schema_kmodulesxyz_client_go_api_v1_CAPIClusterInfo(ref),
+		"kmodules.xyz/client-go/api/v1.CertificatePrivateKey":                                   schema_kmodulesxyz_client_go_api_v1_CertificatePrivateKey(ref),
+		"kmodules.xyz/client-go/api/v1.CertificateSpec":                                         schema_kmodulesxyz_client_go_api_v1_CertificateSpec(ref),
+		"kmodules.xyz/client-go/api/v1.ClusterClaimFeatures":                                    schema_kmodulesxyz_client_go_api_v1_ClusterClaimFeatures(ref),
+		"kmodules.xyz/client-go/api/v1.ClusterClaimInfo":                                        schema_kmodulesxyz_client_go_api_v1_ClusterClaimInfo(ref),
+		"kmodules.xyz/client-go/api/v1.ClusterInfo":                                             schema_kmodulesxyz_client_go_api_v1_ClusterInfo(ref),
+		"kmodules.xyz/client-go/api/v1.ClusterMetadata":                                         schema_kmodulesxyz_client_go_api_v1_ClusterMetadata(ref),
+		"kmodules.xyz/client-go/api/v1.Condition":                                               schema_kmodulesxyz_client_go_api_v1_Condition(ref),
+		"kmodules.xyz/client-go/api/v1.HealthCheckSpec":                                         schema_kmodulesxyz_client_go_api_v1_HealthCheckSpec(ref),
+		"kmodules.xyz/client-go/api/v1.ImageInfo":                                               schema_kmodulesxyz_client_go_api_v1_ImageInfo(ref),
+		"kmodules.xyz/client-go/api/v1.Lineage":                                                 schema_kmodulesxyz_client_go_api_v1_Lineage(ref),
+		"kmodules.xyz/client-go/api/v1.ObjectID":                                                schema_kmodulesxyz_client_go_api_v1_ObjectID(ref),
+		"kmodules.xyz/client-go/api/v1.ObjectInfo":                                              schema_kmodulesxyz_client_go_api_v1_ObjectInfo(ref),
+		"kmodules.xyz/client-go/api/v1.ObjectReference":                                         schema_kmodulesxyz_client_go_api_v1_ObjectReference(ref),
+		"kmodules.xyz/client-go/api/v1.PullCredentials":                                         schema_kmodulesxyz_client_go_api_v1_PullCredentials(ref),
+		"kmodules.xyz/client-go/api/v1.ReadonlyHealthCheckSpec":                                 schema_kmodulesxyz_client_go_api_v1_ReadonlyHealthCheckSpec(ref),
+		"kmodules.xyz/client-go/api/v1.ResourceID":                                              schema_kmodulesxyz_client_go_api_v1_ResourceID(ref),
+		"kmodules.xyz/client-go/api/v1.TLSConfig":                                               schema_kmodulesxyz_client_go_api_v1_TLSConfig(ref),
+		"kmodules.xyz/client-go/api/v1.TimeOfDay":                                               schema_kmodulesxyz_client_go_api_v1_TimeOfDay(ref),
+		"kmodules.xyz/client-go/api/v1.TypeReference":                                           schema_kmodulesxyz_client_go_api_v1_TypeReference(ref),
+		"kmodules.xyz/client-go/api/v1.TypedObjectReference":                                    schema_kmodulesxyz_client_go_api_v1_TypedObjectReference(ref),
+		"kmodules.xyz/client-go/api/v1.X509Subject":                                             schema_kmodulesxyz_client_go_api_v1_X509Subject(ref),
+		"kmodules.xyz/client-go/api/v1.stringSetMerger":                                         schema_kmodulesxyz_client_go_api_v1_stringSetMerger(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference":        schema_external_dns_operator_apis_external_v1alpha1_APIKeySecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider":                  schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiProvider":               schema_external_dns_operator_apis_external_v1alpha1_AkamaiProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiSecretReference":        schema_external_dns_operator_apis_external_v1alpha1_AkamaiSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudProvider":         schema_external_dns_operator_apis_external_v1alpha1_AlibabaCloudProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudSecretReference":  schema_external_dns_operator_apis_external_v1alpha1_AlibabaCloudSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider":                schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CRDConfig":                    schema_external_dns_operator_apis_external_v1alpha1_CRDConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CivoProvider":                 schema_external_dns_operator_apis_external_v1alpha1_CivoProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudMapRecord":               schema_external_dns_operator_apis_external_v1alpha1_CloudMapRecord(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider":           schema_external_dns_operator_apis_external_v1alpha1_CloudflareProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareSecretReference":    schema_external_dns_operator_apis_external_v1alpha1_CloudflareSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSProvider":              schema_external_dns_operator_apis_external_v1alpha1_CoreDNSProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSSecretReference":       schema_external_dns_operator_apis_external_v1alpha1_CoreDNSSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange":                    schema_external_dns_operator_apis_external_v1alpha1_DNSChange(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord":                    schema_external_dns_operator_apis_external_v1alpha1_DNSRecord(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSimpleProvider":             schema_external_dns_operator_apis_external_v1alpha1_DNSimpleProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DigitalOceanProvider":         schema_external_dns_operator_apis_external_v1alpha1_DigitalOceanProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DynamoDBRegistry":             schema_external_dns_operator_apis_external_v1alpha1_DynamoDBRegistry(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExoscaleProvider":             schema_external_dns_operator_apis_external_v1alpha1_ExoscaleProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS":                  schema_external_dns_operator_apis_external_v1alpha1_ExternalDNS(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSList":              schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSList(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec":              schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus":            schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSStatus(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GandiProvider":                schema_external_dns_operator_apis_external_v1alpha1_GandiProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GatewayRouteConfig":           schema_external_dns_operator_apis_external_v1alpha1_GatewayRouteConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference":       schema_external_dns_operator_apis_external_v1alpha1_GenericSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoDaddyProvider":              schema_external_dns_operator_apis_external_v1alpha1_GoDaddyProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider":               schema_external_dns_operator_apis_external_v1alpha1_GoogleProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryProvider":             schema_external_dns_operator_apis_external_v1alpha1_InMemoryProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryRecord":               schema_external_dns_operator_apis_external_v1alpha1_InMemoryRecord(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig":                schema_external_dns_operator_apis_external_v1alpha1_IngressConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.IstioConfig":                  schema_external_dns_operator_apis_external_v1alpha1_IstioConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.LinodeProvider":               schema_external_dns_operator_apis_external_v1alpha1_LinodeProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.NS1Provider":                  schema_external_dns_operator_apis_external_v1alpha1_NS1Provider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig":                   schema_external_dns_operator_apis_external_v1alpha1_NodeConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCIProvider":                  schema_external_dns_operator_apis_external_v1alpha1_OCIProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCISecretReference":           schema_external_dns_operator_apis_external_v1alpha1_OCISecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHProvider":                  schema_external_dns_operator_apis_external_v1alpha1_OVHProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHSecretReference":           schema_external_dns_operator_apis_external_v1alpha1_OVHSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSProvider":                 schema_external_dns_operator_apis_external_v1alpha1_PDNSProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSSecretReference":          schema_external_dns_operator_apis_external_v1alpha1_PDNSSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PendingChanges":               schema_external_dns_operator_apis_external_v1alpha1_PendingChanges(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeProvider":               schema_external_dns_operator_apis_external_v1alpha1_PiholeProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeSecretReference":        schema_external_dns_operator_apis_external_v1alpha1_PiholeSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ProviderSpecificProperty":     schema_external_dns_operator_apis_external_v1alpha1_ProviderSpecificProperty(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136Provider":              schema_external_dns_operator_apis_external_v1alpha1_RFC2136Provider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136SecretReference":       schema_external_dns_operator_apis_external_v1alpha1_RFC2136SecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.Registry":                     schema_external_dns_operator_apis_external_v1alpha1_Registry(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.SafetyConfig":                 schema_external_dns_operator_apis_external_v1alpha1_SafetyConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ScalewayProvider":             schema_external_dns_operator_apis_external_v1alpha1_ScalewayProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig":                schema_external_dns_operator_apis_external_v1alpha1_ServiceConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig":                 schema_external_dns_operator_apis_external_v1alpha1_SourceConfig(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryption":                schema_external_dns_operator_apis_external_v1alpha1_TXTEncryption(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryptionSecretReference": schema_external_dns_operator_apis_external_v1alpha1_TXTEncryptionSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTRegistry":                  schema_external_dns_operator_apis_external_v1alpha1_TXTRegistry(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TLSSecretKeys":                schema_external_dns_operator_apis_external_v1alpha1_TLSSecretKeys(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference":         schema_external_dns_operator_apis_external_v1alpha1_TokenSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPProvider":              schema_external_dns_operator_apis_external_v1alpha1_TransIPProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPSecretReference":       schema_external_dns_operator_apis_external_v1alpha1_TransIPSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo":                     schema_external_dns_operator_apis_external_v1alpha1_TypeInfo(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookProvider":              schema_external_dns_operator_apis_external_v1alpha1_WebhookProvider(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookSecretReference":       schema_external_dns_operator_apis_external_v1alpha1_WebhookSecretReference(ref),
+		"kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookServer":                schema_external_dns_operator_apis_external_v1alpha1_WebhookServer(ref),
 	}
 }
 
@@ -19898,6 +19946,44 @@ func schema_kmodulesxyz_client_go_api_v1_stringSetMerger(ref common.ReferenceCal
 	}
 }
 
+func schema_external_dns_operator_apis_external_v1alpha1_APIKeySecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIKeySecretReference contains the name of the secret holding the API key and the API secret of a provider",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the API key in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiSecretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the API secret in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "apiKeyKey", "apiSecretKey"},
+			},
+		},
+	}
+}
+
 func schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19984,7 +20070,8 @@ func schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref common.
 					},
 					"sdCreateTag": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "When using the AWS CloudMap provider, add these tags to created services",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -20004,7 +20091,6 @@ func schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref common.
 						},
 					},
 				},
-				Required: []string{"sdCreateTag"},
 			},
 		},
 		Dependencies: []string{
@@ -20012,6 +20098,148 @@ func schema_external_dns_operator_apis_external_v1alpha1_AWSProvider(ref common.
 	}
 }
 
+func schema_external_dns_operator_apis_external_v1alpha1_AkamaiProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"edgercSection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Akamai provider, the section of the .edgerc file to read the credentials from (default: default)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding either the EdgeGrid tokens or an .edgerc file",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_AkamaiSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AkamaiSecretReference contains the name of the secret holding the EdgeGrid credentials of the Akamai API. The four tokens are used when they are all set, otherwise the .edgerc file is used.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceConsumerDomainKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the service consumer domain, the host of the Akamai API, in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientTokenKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client token in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientSecretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client secret in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessTokenKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the access token in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"edgercKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the .edgerc file in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_AlibabaCloudProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zoneType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Alibaba Cloud provider, only manage the zones of this type (default: all zones)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the Alibaba Cloud config file. When not set, the RAM role of the ECS instance running the operator is used.",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudSecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_AlibabaCloudSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlibabaCloudSecretReference contains the name of the secret holding the Alibaba Cloud config file",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the config file in the provider secret. It is a JSON document with the regionId, accessKeyId, accessKeySecret and vpcId of the account.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "configKey"},
+			},
+		},
+	}
+}
+
 func schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20027,7 +20255,7 @@ func schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref commo
 					},
 					"subscriptionId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the Azure provider, specify the Azure configuration file. (required for azure-private-dns)",
+							Description: "When using the Azure provider, override the Azure subscription to use (required for azure-private-dns)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20039,6 +20267,20 @@ func schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref commo
 							Format:      "",
 						},
 					},
+					"zoneType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Azure provider, manage the records of public DNS zones or of private DNS zones linked to virtual networks. Private zones require resourceGroup and subscriptionId. (default: public)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"activeDirectoryAuthorityHost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Azure provider, override the Active Directory authority host in config file, for sovereign clouds, e.g. `https://login.chinacloudapi.cn/` or `https://login.microsoftonline.us/`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"zonesCacheDuration": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -20067,6 +20309,104 @@ func schema_external_dns_operator_apis_external_v1alpha1_AzureProvider(ref commo
 	}
 }
 
+func schema_external_dns_operator_apis_external_v1alpha1_CRDConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API version of the custom resource read by the crd source (default: externaldns.k8s.io/v1alpha1)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the custom resource read by the crd source (default: DNSEndpoint)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit sources of endpoints to a specific namespace (default: all namespaces)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotationFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_CivoProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the Civo API token",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_CloudMapRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudMapRecord identifies the AWS Cloud Map namespace and service a DNS record is registered in",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceID is the id of the Cloud Map namespace",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceID is the id of the Cloud Map service",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespaceID", "serviceID"},
+			},
+		},
+	}
+}
+
 func schema_external_dns_operator_apis_external_v1alpha1_CloudflareProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20170,181 +20510,1872 @@ func schema_external_dns_operator_apis_external_v1alpha1_CloudflareSecretReferen
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_DNSRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_CoreDNSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DNSRecord hold the DNS name and target address, if there are multiple target address then the addresses are joint by separator ';' between them (ex: 1:2:3:4;6:7:8:9)",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "target is the list of target address",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
+					"etcdURLs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "dns name is the domain name for this record",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
+							Description: "When using the CoreDNS provider, specify the URLs of the etcd cluster CoreDNS reads the records from. The first URL decides whether TLS is used (default: http://localhost:2379)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the CoreDNS provider, specify the prefix name (default: /skydns/)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsServerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the CoreDNS provider with TLS, override the server name used to verify the etcd certificate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsInsecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the CoreDNS provider with TLS, disable verification of the etcd certificate",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret credential information, holding the etcd basic auth credentials and TLS certificates",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSSecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_CoreDNSSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSSecretReference contains the name of the secret holding the basic auth credentials and the TLS certificates of the etcd cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usernameKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the etcd username in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"passwordKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the etcd password in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the CA certificate in the provider secret, used to verify the DNS server over TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCertKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client certificate in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCertKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client certificate key in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_DNSChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSChange is a DNS record created, updated or deleted by a plan",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the domain name of the record",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the record type (ex: A, CNAME, TXT)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets of the record",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL of the record in seconds",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"setIdentifier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetIdentifier distinguishes records with the same name and type (ex: weighted or latency routing)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerSpecific": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderSpecific are the provider specific properties of the record",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ProviderSpecificProperty"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.ProviderSpecificProperty"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_DNSRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSRecord hold the DNS name and target address, if there are multiple target address then the addresses are joint by separator ';' between them (ex: 1:2:3:4;6:7:8:9)",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "target is the list of target address",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dns name is the domain name for this record",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cloudMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudMap identifies the AWS Cloud Map service of the record, when using the aws-sd provider",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudMapRecord"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudMapRecord"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_DNSimpleProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"accountID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DNSimple provider, the account to manage the zones of. It is looked up from the token when not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the DNSimple OAuth token",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_DigitalOceanProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiPageSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DigitalOcean provider, configure the page size used when querying the DigitalOcean API (default: 50)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the DigitalOcean API token",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_DynamoDBRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"table": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DynamoDB registry, the name of the table (default: external-dns)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DynamoDB registry, the AWS region of the table (default: the region of the AWS credentials)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DynamoDB registry, the URL of the DynamoDB API, to use DynamoDB Local or a compatible service",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createTable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the DynamoDB registry, create the table with the key schema of the registry when it does not exist. The AWS credentials need the dynamodb:CreateTable permission.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ExoscaleProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiEnvironment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Exoscale provider, the API environment (default: api)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Exoscale provider, the zone of the API (default: ch-gva-2)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the Exoscale API key, as apiKeyKey, and API secret, as apiSecretKey",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalDNS is the Schema for the externaldns API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalDNSList contains a list of ExternalDNS",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalDNSSpec defines the desired state of ExternalDNS",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Request timeout when calling Kubernetes API. 0s means no timeout",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RELATED TO PROCESSING SOURCE The resource types that are queried for endpoints; List of source. ex: source, ingress, node etc. source:\n   group: \"\"\n   version: v1\n   kind: Service\nUse sources to read endpoints from more than one kind",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of sources whose endpoints are merged and published together under the same owner. It is combined with source, when both are set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig"),
+									},
+								},
+							},
+						},
+					},
+					"ocRouterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If source is openshift router then you can pass the ingress controller name. Based on this name the external dns will select the respective router from the route status and map that routeCanonicalHostname to the route host while creating a CNAME record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gatewayNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit Gateways of route endpoints to a specific namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gatewayLabelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter Gateways of Route endpoints via label selector",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectorSourceServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The server to connect for connector source, valid only when using connector source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manageDNSRecordTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comma separated list of record types to manage (default: A, CNAME; supported: A,CNAME,NS)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set globally a list of default IP address that will apply as a target instead of source addresses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RELATED TO PROVIDERS The DNS provider where the DNS records will be created. (AWS, Cloudflare)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit possible target zones by a domain suffix",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"excludeDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclude subdomains",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"zoneIDFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter target zones by hosted zone id",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"aws": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWS provider information, used by the aws and aws-sd providers",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider"),
+						},
+					},
+					"cloudflare": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cloudflare provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure provider infomation",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider"),
+						},
+					},
+					"google": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Google provider",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider"),
+						},
+					},
+					"rfc2136": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RFC2136 provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136Provider"),
+						},
+					},
+					"pdns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PowerDNS provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSProvider"),
+						},
+					},
+					"coredns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoreDNS provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSProvider"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookProvider"),
+						},
+					},
+					"digitalocean": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DigitalOcean provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DigitalOceanProvider"),
+						},
+					},
+					"linode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Linode provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.LinodeProvider"),
+						},
+					},
+					"scaleway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scaleway provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ScalewayProvider"),
+						},
+					},
+					"civo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Civo provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CivoProvider"),
+						},
+					},
+					"dnsimple": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSimple provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSimpleProvider"),
+						},
+					},
+					"gandi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gandi provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GandiProvider"),
+						},
+					},
+					"godaddy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GoDaddy provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoDaddyProvider"),
+						},
+					},
+					"akamai": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Akamai provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiProvider"),
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCIProvider"),
+						},
+					},
+					"pihole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pi-hole provider information. Pi-hole cannot store ownership records, so the noop registry is always used with it.",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeProvider"),
+						},
+					},
+					"ns1": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NS1 provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.NS1Provider"),
+						},
+					},
+					"ovh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OVH provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHProvider"),
+						},
+					},
+					"transip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransIP provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPProvider"),
+						},
+					},
+					"exoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exoscale provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExoscaleProvider"),
+						},
+					},
+					"alibabacloud": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alibaba Cloud provider information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudProvider"),
+						},
+					},
+					"inmemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InMemory provider information, for local development and tests. Its records are shown in status.inMemoryRecords.",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryProvider"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When enabled, the plan is computed and published in status.pendingChanges, but not applied. A deleted ExternalDNS keeps its finalizer and publishes the records it would delete until the dry run is disabled or the deletion policy is Retain",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval decides which plans are applied only after they are approved, by annotating the ExternalDNS with external-dns.appscode.com/approve-plan set to status.planHash (default: Automatic, options: Automatic, Manual, DeletesOnly). With DeletesOnly, only plans deleting records need approval. The annotation is removed once its plan is applied",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"safety": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Safety limits what a single sync is allowed to change",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.SafetyConfig"),
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval for periodic synchronization of the DNS records, so changes made outside of the operator are corrected, e.g. 5m (default: 1m). 0 disables the periodic synchronization",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "POLICY INFORMATION\n\nModify how DNS records are synchronized between sources and providers (default: sync, options: sync, upsert-only, create-only)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy decides what happens to the DNS records when the ExternalDNS is deleted (options: Delete, Retain, Orphan). Retain keeps the records and their ownership records, Orphan keeps the records but deletes their ownership records so another owner can adopt them. Defaults to Delete when the policy is sync and Retain otherwise. Orphan requires the txt or noop registry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"registry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "REGISTRY information\n\nThe registry to use to keep track of DNS record ownership (default: the txt registry)",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.Registry"),
+						},
+					},
+					"txtOwnerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the TXT registry, a name that identifies this instance of ExternalDNS (default: default)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"txtPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the TXT registry, a custom string that's prefixed to each ownership DNS record (optional). Could contain record type template like '%{record_type}-prefix-'. Mutual exclusive with txt-suffix!",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"txtSuffix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the TXT registry, a custom string that's suffixed to the host portion of each ownership DNS record. Could contain record type template like '-%{record_type}-suffix'. Mutual exclusive with txt-prefix!",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"txtWildcardReplacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the TXT registry, a custom string that's used instead of an asterisk for TXT records corresponding to wildcard DNS records",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"provider"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.AkamaiProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.AlibabaCloudProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.CivoProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.CoreDNSProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSimpleProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.DigitalOceanProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExoscaleProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.GandiProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoDaddyProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.LinodeProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.NS1Provider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCIProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136Provider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.Registry", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.SafetyConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ScalewayProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookProvider"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalDNSStatus defines the observed state of ExternalDNS",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase indicates the current state of the controller (ex: Failed,InProgress,Current)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration indicates the latest generation that successfully reconciled",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions describe the current condition of the CRD",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"dnsRecords": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSRecord is the list of records that this external dns operator registered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord"),
+									},
+								},
+							},
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the time the DNS records were last synchronized with the provider",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextSyncTime is the time of the next periodic synchronization",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"pendingChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingChanges are the changes computed in dry run mode or waiting for approval, that are not applied to the provider",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.PendingChanges"),
+						},
+					},
+					"planHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanHash is the content hash of the pending changes, used to approve them",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inMemoryRecords": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InMemoryRecords are the records held by the inmemory provider, including the ownership records of the registry",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryRecord"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.InMemoryRecord", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.PendingChanges"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_GandiProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sharingID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Gandi provider, the sharing id of the organization to manage the domains of",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the Gandi personal access token",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_GatewayRouteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit sources of endpoints to a specific namespace (default: all namespaces)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreHostnameAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"combineFQDNAndAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Combine FQDN template and Annotations instead of overwriting",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"annotationFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fqdnTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gatewayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit Gateways of route endpoints to a specific name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_GenericSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GenericSecretReference contains the information of the provider secret. Name is for secret name and CredentialKey is for specifying the key of the secret. It is considered optional where workload identity or IRSA (IAM Role for Service Account) is used, otherwise it is mandatory",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "credential key of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "credentialKey"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_GoDaddyProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the GoDaddy provider, the TTL of the records, which is at least 600 (default: 600)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the GoDaddy provider, use the OTE (test) environment of the GoDaddy API (default: disabled)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the GoDaddy API key and API secret",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_GoogleProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Google provider, current project is auto-detected, when running on GCP. Specify other project with this. Must be specified when running outside GCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batchChangeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Google provider, set the maximum number of changes that will be applied in each batch",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchChangeInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Google provider, set the interval between batch changes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"zoneVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Google provider, filter for zones with this visibility (optional, options: public, private)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret credential information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_InMemoryProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the inmemory provider, the zones created in memory (default: the domain filter). The records are kept by the operator process, so they are lost when it restarts or when the zones or the domain filter change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_InMemoryRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InMemoryRecord is a record held by the inmemory provider",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the domain name of the record",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the record (ex: A, CNAME, TXT)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are the targets of the record",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_IngressConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit sources of endpoints to a specific namespace (default: all namespaces)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreHostnameAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"combineFQDNAndAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Combine FQDN template and Annotations instead of overwriting",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"annotationFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fqdnTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreIngressTLSSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignore TLS Spec section in ingresses resources, applicable only for ingress source",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ignoreIngressRulesSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignore rules spec section in ingresses resources, applicable only for ingress sources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_IstioConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit sources of endpoints to a specific namespace (default: all namespaces)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreHostnameAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"combineFQDNAndAnnotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Combine FQDN template and Annotations instead of overwriting",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"annotationFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fqdnTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_LinodeProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the Linode provider, override the URL of the Linode API",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the Linode API token",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_NS1Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalDNS is the Schema for the externaldns API",
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the NS1 provider, the endpoint of the NS1 API, for a managed DNS or private DNS installation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreSSL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the NS1 provider, skip the verification of the TLS certificate of the NS1 API (default: disabled)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minTTLSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the NS1 provider, the minimum TTL of the records in seconds",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the NS1 API key",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TokenSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_NodeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fqdnTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotationFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_OCIProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"authMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OCI provider, authenticate with the config file of the provider secret, or as the instance principal of the node (default: ConfigFile)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compartmentOCID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OCI provider, the OCID of the compartment containing the zones. Required with instance principal authentication, it overrides the compartment of the config file otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OCI provider, filter for zones with this scope (default: GLOBAL)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneCacheDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OCI provider, set the zones list cache TTL (0s to disable)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the OCI config file and its private key, required with config file authentication",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCISecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OCISecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_OCISecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCISecretReference contains the name of the secret holding the OCI config file, the oci.yaml of external-dns, and the private key of the API signing key",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the OCI config file in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"privateKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the private key in the provider secret. It replaces the key of the config file when set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "configKey"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_OVHProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OVH provider, the name or the URL of the OVH API endpoint (default: ovh-eu)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OVH provider, the maximum number of requests per second to the OVH API (default: 20)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"enableCNAMERelative": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the OVH provider, write the targets of CNAME records relative to their zone (default: disabled)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret holding the OVH application key, application secret and consumer key",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.OVHSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_OVHSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OVHSecretReference contains the name of the secret holding the application credentials of the OVH API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"applicationKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the application key in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"applicationSecretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the application secret in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consumerKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the consumer key in the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "applicationKeyKey", "applicationSecretKey", "consumerKeyKey"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_PDNSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the PowerDNS provider, specify the URL to the pdns server (required when --provider=pdns)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serverID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the PowerDNS provider, specify the id of the server to retrieve. Should be `localhost` except when the server is behind a proxy (default: localhost)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"skipTLSVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the PowerDNS provider, disable verification of any TLS certificates",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret credential information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSSecretReference"),
+						},
+					},
+				},
+				Required: []string{"server", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PDNSSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_PDNSSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PDNSSecretReference contains the name of the secret holding the API key and the TLS certificates of the PowerDNS server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"apiKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "key of the API key in the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"caKey": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "key of the CA certificate in the provider secret, used to verify the DNS server over TLS",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"clientCertKey": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec"),
+							Description: "key of the client certificate in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"clientCertKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus"),
+							Description: "key of the client certificate key in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "apiKeyKey"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSSpec", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNSStatus"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_PendingChanges(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalDNSList contains a list of ExternalDNS",
+				Description: "PendingChanges are the changes of a computed plan that are not applied yet",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"create": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Records that need to be created",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"updateOld": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Records that need to be updated (current data)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"updateNew": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Records that need to be updated (desired data)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"delete": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Records that need to be deleted",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS"),
+										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ExternalDNS"},
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSChange"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_PiholeProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalDNSSpec defines the desired state of ExternalDNS",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"requestTimeout": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Request timeout when calling Kubernetes API. 0s means no timeout",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "When using the Pi-hole provider, the base URL of the Pi-hole web server",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"source": {
+					"tlsInsecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RELATED TO PROCESSING SOURCE The resource types that are queried for endpoints; List of source. ex: source, ingress, node etc. source:\n   group: \"\"\n   version: v1\n   kind: Service",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig"),
+							Description: "When using the Pi-hole provider, skip verification of any TLS certificates served by the Pi-hole web server",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"ocRouterName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If source is openshift router then you can pass the ingress controller name. Based on this name the external dns will select the respective router from the route status and map that routeCanonicalHostname to the route host while creating a CNAME record.",
+							Description: "When using the Pi-hole provider, the API version of the Pi-hole web server (default: 5)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"gatewayNamespace": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit Gateways of route endpoints to a specific namespace",
+							Description: "Provider secret holding the password of the Pi-hole web server, not needed when it has no password",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeSecretReference"),
+						},
+					},
+				},
+				Required: []string{"server"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.PiholeSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_PiholeSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PiholeSecretReference contains the name of the secret holding the password of the Pi-hole web server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"gatewayLabelFilter": {
+					"passwordKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter Gateways of Route endpoints via label selector",
+							Description: "key of the password in the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"connectorSourceServer": {
+				},
+				Required: []string{"name", "passwordKey"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_ProviderSpecificProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderSpecificProperty is a provider specific property of a DNS record",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The server to connect for connector source, valid only when using connector source",
+							Description: "Name of the property",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"manageDNSRecordTypes": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Comma separated list of record types to manage (default: A, CNAME; supported: A,CNAME,NS)",
+							Description: "Value of the property",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_RFC2136Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider, specify the host of the DNS server (the first host is used, unless a load balancing strategy is set)",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -20357,9 +22388,16 @@ func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref com
 							},
 						},
 					},
-					"defaultTargets": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set globally a list of default IP address that will apply as a target instead of source addresses.",
+							Description: "When using the RFC2136 provider, specify the port of the DNS server",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider, specify the zones entries of the DNS server to use",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -20372,270 +22410,261 @@ func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSSpec(ref com
 							},
 						},
 					},
-					"provider": {
+					"insecure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RELATED TO PROVIDERS The DNS provider where the DNS records will be created. (AWS, Cloudflare)",
-							Default:     "",
+							Description: "When using the RFC2136 provider, specify whether to attach TSIG or not (default: false, requires TSIG)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tsigKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider, specify the TSIG key name to attach to DNS update messages",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"domainFilter": {
+					"tsigSecretAlg": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit possible target zones by a domain suffix",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "When using the RFC2136 provider, specify the TSIG (base64) algorithm to attach (default: hmac-sha256.)",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"excludeDomains": {
+					"taxfr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exclude subdomains",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "When using the RFC2136 provider, specify whether to use AXFR to list the records (default: true)",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"zoneIDFilter": {
+					"minTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter target zones by hosted zone id",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "When using the RFC2136 provider, specify minimal TTL (in duration format) for records. This value will be used if the provided TTL for a service/ingress is lower than this",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"aws": {
+					"batchChangeSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AWS provider information",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider"),
+							Description: "When using the RFC2136 provider, set the maximum number of changes that will be applied in each batch",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"cloudflare": {
+					"createPTR": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cloudflare provider information",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider"),
+							Description: "When using the RFC2136 provider, enable PTR management",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"azure": {
+					"loadBalancingStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Azure provider infomation",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider"),
+							Description: "When using the RFC2136 provider, specify the load balancing strategy for the hosts (default: disabled, options: disabled, round-robin, random)",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"google": {
+					"gssTSIG": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Google provider",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider"),
+							Description: "When using the RFC2136 provider, specify whether to use secure updates with GSS-TSIG using Kerberos (default: false, requires the kerberos username and password in the secret)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"kerberosRealm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider with GSS-TSIG, specify the realm of the user credentials used for Kerberos authentication",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"useTLS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider, communicate with the DNS server over TLS",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"skipTLSVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the RFC2136 provider, disable verification of any TLS certificates",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider secret credential information, not needed in insecure mode",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136SecretReference"),
+						},
+					},
+				},
+				Required: []string{"host"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.RFC2136SecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_RFC2136SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RFC2136SecretReference contains the name of the secret holding the TSIG secret, the Kerberos credentials used with GSS-TSIG, and the TLS certificates used to connect to the DNS server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"policy": {
+					"tsigSecretKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "POLICY INFORMATION\n\nModify how DNS records are synchronized between sources and providers (default: sync, options: sync, upsert-only, create-only)",
+							Description: "key of the TSIG secret in the provider secret",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"registry": {
+					"kerberosUsernameKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "REGISTRY information\n\nThe registry implementation to use to keep track of DNS record ownership (default: txt, options: txt, noop, aws-sd)",
+							Description: "key of the Kerberos username in the provider secret, used with GSS-TSIG",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"txtOwnerID": {
+					"kerberosPasswordKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the TXT registry, a name that identifies this instance of ExternalDNS (default: default)",
+							Description: "key of the Kerberos password in the provider secret, used with GSS-TSIG",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"txtPrefix": {
+					"caKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the TXT registry, a custom string that's prefixed to each ownership DNS record (optional). Could contain record type template like '%{record_type}-prefix-'. Mutual exclusive with txt-suffix!",
+							Description: "key of the CA certificate in the provider secret, used to verify the DNS server over TLS",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"txtSuffix": {
+					"clientCertKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the TXT registry, a custom string that's suffixed to the host portion of each ownership DNS record. Could contain record type template like '-%{record_type}-suffix'. Mutual exclusive with txt-prefix!",
+							Description: "key of the client certificate in the provider secret, used for mutual TLS",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"txtWildcardReplacement": {
+					"clientCertKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the TXT registry, a custom string that's used instead of an asterisk for TXT records corresponding to wildcard DNS records",
+							Description: "key of the client certificate key in the provider secret, used for mutual TLS",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"source", "provider"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.AWSProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.AzureProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.CloudflareProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.GoogleProvider", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.SourceConfig"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_ExternalDNSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalDNSStatus defines the observed state of ExternalDNS",
+				Description: "Registry keeps track of the ownership of the DNS records, with the settings of its type",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase indicates the current state of the controller (ex: Failed,InProgress,Current)",
+							Description: "Type of the registry (options: txt, noop, aws-sd, dynamodb). The aws-sd provider only works with the aws-sd, noop and dynamodb registries, the pihole provider only with noop and the dynamodb registry only with the aws and aws-sd providers, whose credentials it shares.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration indicates the latest generation that successfully reconciled",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
+					"txt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions describe the current condition of the CRD",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
-									},
-								},
-							},
+							Description: "TXT registry information",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTRegistry"),
 						},
 					},
-					"dnsRecords": {
+					"dynamodb": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSRecord is the list of records that this external dns operator registered",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord"),
-									},
-								},
-							},
+							Description: "When using the DynamoDB registry, the table the ownership records are kept in",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.DynamoDBRegistry"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.DNSRecord"},
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.DynamoDBRegistry", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTRegistry"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_GenericSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_SafetyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GenericSecretReference contains the information of the provider secret. Name is for secret name and CredentialKey is for specifying the key of the secret. It is considered optional where workload identity or IRSA (IAM Role for Service Account) is used, otherwise it is mandatory",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name of the provider secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"credentialKey": {
+					"maxDeletesPerSync": {
 						SchemaProps: spec.SchemaProps{
-							Description: "credential key of the provider secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Maximum number of records a single sync may delete, as an absolute number (ex: 10) or a percentage of the records currently owned by this instance (ex: 25%). A plan deleting more is not applied until it is approved with the external-dns.appscode.com/approve-plan annotation. The limit also guards the deletion of the records when the ExternalDNS is deleted, unless it has the annotation external-dns.appscode.com/override-delete-safety: \"true\"",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
 				},
-				Required: []string{"name", "credentialKey"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_GoogleProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_ScalewayProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"project": {
+					"apiURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the Google provider, current project is auto-detected, when running on GCP. Specify other project with this. Must be specified when running outside GCP.",
+							Description: "When using the Scaleway provider, override the URL of the Scaleway API",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"batchChangeSize": {
+					"pageSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When using the Google provider, set the maximum number of changes that will be applied in each batch",
+							Description: "When using the Scaleway provider, configure the page size used when querying the Scaleway API (default: 1000)",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"batchChangeInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "When using the Google provider, set the interval between batch changes",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"zoneVisibility": {
-						SchemaProps: spec.SchemaProps{
-							Description: "When using the Google provider, filter for zones with this visibility (optional, options: public, private)",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider secret credential information",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference"),
+							Description: "Provider secret holding the Scaleway access key, as apiKeyKey, and secret key, as apiSecretKey",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"),
 						},
 					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.GenericSecretReference"},
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.APIKeySecretReference"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_IngressConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_ServiceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -20671,239 +22700,536 @@ func schema_external_dns_operator_apis_external_v1alpha1_IngressConfig(ref commo
 					},
 					"labelFilter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fqdnTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compatibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Process  annotation semantics from legacy implementations",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publishInternal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow  externals-dns to publish DNS records for ClusterIP services",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"publishHostIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow external-dns to publish host-ip for headless services",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"alwaysPublishNotReadyAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Always publish also not ready addresses for headless services",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"serviceTypeFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The service types to take care about (default all, expected: ClusterIP, NodePort, LoadBalancer or ExternalName)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_SourceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TypeInfo contains the source type of the external dns example: type:\n\t group:\n\t version:\n\t kind:",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo"),
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For source type Node",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For source type Service",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig"),
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For source type Ingress",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig"),
+						},
+					},
+					"gatewayRoute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For Gateway API source types HTTPRoute, GRPCRoute, TLSRoute, TCPRoute and UDPRoute",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.GatewayRouteConfig"),
+						},
+					},
+					"istio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For source types Gateway and VirtualService of group networking.istio.io",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.IstioConfig"),
+						},
+					},
+					"crd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For source type DNSEndpoint, or any custom resource with the same schema",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.CRDConfig"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.CRDConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.GatewayRouteConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.IstioConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TLSSecretKeys(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSSecretKeys are the keys of the TLS certificates in a provider secret, used to connect to the DNS server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"caKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the CA certificate in the provider secret, used to verify the DNS server over TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCertKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client certificate in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCertKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client certificate key in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TXTEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "secret holding the AES-256 key the ownership records are encrypted with",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryptionSecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryptionSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TXTEncryptionSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TXTEncryptionSecretReference contains the name of the secret holding the AES keys of the TXT registry. The keys are 32 bytes long, in plain text or base64-encoded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fqdnTemplate": {
+					"aesKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
+							Description: "key of the AES key in the secret, the ownership records are encrypted with",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ignoreIngressTLSSpec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ignore TLS Spec section in ingresses resources, applicable only for ingress source",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"ignoreIngressRulesSpec": {
+					"oldAESKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ignore rules spec section in ingresses resources, applicable only for ingress sources",
-							Type:        []string{"boolean"},
+							Description: "key of the previous AES key in the secret, while rotating the key. The ownership records encrypted with the previous key are encrypted again with the new one, after which the previous key can be removed.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "aesKeyKey"},
 			},
 		},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_NodeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_TXTRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"fqdnTemplate": {
+					"encryption": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "When using the TXT registry, encrypt the ownership records, so they do not disclose the owner and the resources of the records",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryption"),
 						},
 					},
-					"annotationFilter": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TXTEncryption"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TokenSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenSecretReference contains the name of the secret holding the API token of a provider",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Description: "Name of the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"labelFilter": {
+					"tokenKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
+							Description: "key of the API token in the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "tokenKey"},
 			},
 		},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_ServiceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_TransIPProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"namespace": {
+					"accountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limit sources of endpoints to a specific namespace (default: all namespaces)",
+							Description: "When using the TransIP provider, the name of the TransIP account",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ignoreHostnameAnnotation": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ignore hostname annotation when generating DNS names, valid only when using fqdn-template is set",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Provider secret holding the private key of the TransIP API",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPSecretReference"),
 						},
 					},
-					"combineFQDNAndAnnotation": {
+				},
+				Required: []string{"accountName", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.TransIPSecretReference"},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TransIPSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TransIPSecretReference contains the name of the secret holding the private key of the TransIP API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Combine FQDN template and Annotations instead of overwriting",
-							Type:        []string{"boolean"},
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"annotationFilter": {
+					"privateKeyKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter sources managed by external-dns via label selector when listing all resources",
+							Description: "key of the private key in the provider secret",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"labelFilter": {
+				},
+				Required: []string{"name", "privateKeyKey"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_TypeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TypeInfo is for source type, contains the group,version,kind information of the source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter sources managed by external-dns via annotation using label selector semantics",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"fqdnTemplate": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A templated string that's used to generate DNS names from source that don't define a hostname themselves, or to add a hostname suffix when paired with the fake source",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"compatibility": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Process  annotation semantics from legacy implementations",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"group", "version", "kind"},
+			},
+		},
+	}
+}
+
+func schema_external_dns_operator_apis_external_v1alpha1_WebhookProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When using the webhook provider, specify the URL of the provider. It defaults to the service of the webhook server when server is set (default: http://localhost:8888)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"publishInternal": {
+					"readTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow  externals-dns to publish DNS records for ClusterIP services",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "When using the webhook provider, the timeout of requests reading from the provider (default: 5s)",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"publishHostIP": {
+					"writeTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow external-dns to publish host-ip for headless services",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "When using the webhook provider, the timeout of requests applying changes to the provider (default: 10s)",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"alwaysPublishNotReadyAddresses": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Always publish also not ready addresses for headless services",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Provider secret holding the bearer token and TLS certificates used to call the webhook provider",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookSecretReference"),
 						},
 					},
-					"serviceTypeFilter": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The service types to take care about (default all, expected: ClusterIP, NodePort, LoadBalancer or ExternalName)",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Server runs the webhook provider as a Deployment and a Service owned by the ExternalDNS",
+							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookServer"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookSecretReference", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.WebhookServer"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_SourceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_WebhookSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WebhookSecretReference contains the name of the secret holding the bearer token and the TLS certificates used to call the webhook provider",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TypeInfo contains the source type of the external dns example: type:\n\t group:\n\t version:\n\t kind:",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo"),
+							Description: "Name of the provider secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"node": {
+					"tokenKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For source type Node",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig"),
+							Description: "key of the bearer token in the provider secret",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"service": {
+					"caKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For source type Service",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig"),
+							Description: "key of the CA certificate in the provider secret, used to verify the DNS server over TLS",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ingress": {
+					"clientCertKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For source type Ingress",
-							Ref:         ref("kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig"),
+							Description: "key of the client certificate in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCertKeyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the client certificate key in the provider secret, used for mutual TLS",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"kubeops.dev/external-dns-operator/apis/external/v1alpha1.IngressConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.NodeConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.ServiceConfig", "kubeops.dev/external-dns-operator/apis/external/v1alpha1.TypeInfo"},
 	}
 }
 
-func schema_external_dns_operator_apis_external_v1alpha1_TypeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_external_dns_operator_apis_external_v1alpha1_WebhookServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypeInfo is for source type, contains the group,version,kind information of the source",
+				Description: "WebhookServer is the webhook provider run by the operator for a single ExternalDNS",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"group": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Image of the webhook provider",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Arguments of the webhook provider",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"kind": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Port the webhook provider listens on",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables of the webhook provider, usually the credentials of the DNS vendor",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"envFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources to populate environment variables of the webhook provider",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compute resources of the webhook provider",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
 				},
-				Required: []string{"group", "version", "kind"},
+				Required: []string{"image"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements"},
 	}
 }
@@ -24,10 +24,29 @@ package v1alpha1
 import (
 	time "time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	v1 "kmodules.xyz/client-go/api/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeySecretReference) DeepCopyInto(out *APIKeySecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeySecretReference.
+func (in *APIKeySecretReference) DeepCopy() *APIKeySecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeySecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSProvider) DeepCopyInto(out *AWSProvider) {
 	*out = *in
@@ -110,6 +129,90 @@ func (in *AWSProvider) DeepCopy() *AWSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AkamaiProvider) DeepCopyInto(out *AkamaiProvider) {
+	*out = *in
+	if in.EdgercSection != nil {
+		in, out := &in.EdgercSection, &out.EdgercSection
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(AkamaiSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AkamaiProvider.
+func (in *AkamaiProvider) DeepCopy() *AkamaiProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AkamaiProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AkamaiSecretReference) DeepCopyInto(out *AkamaiSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AkamaiSecretReference.
+func (in *AkamaiSecretReference) DeepCopy() *AkamaiSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AkamaiSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlibabaCloudProvider) DeepCopyInto(out *AlibabaCloudProvider) {
+	*out = *in
+	if in.ZoneType != nil {
+		in, out := &in.ZoneType, &out.ZoneType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(AlibabaCloudSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlibabaCloudProvider.
+func (in *AlibabaCloudProvider) DeepCopy() *AlibabaCloudProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AlibabaCloudProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlibabaCloudSecretReference) DeepCopyInto(out *AlibabaCloudSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlibabaCloudSecretReference.
+func (in *AlibabaCloudSecretReference) DeepCopy() *AlibabaCloudSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlibabaCloudSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureProvider) DeepCopyInto(out *AzureProvider) {
 	*out = *in
@@ -128,6 +231,16 @@ func (in *AzureProvider) DeepCopyInto(out *AzureProvider) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneType != nil {
+		in, out := &in.ZoneType, &out.ZoneType
+		*out = new(AzureZoneType)
+		**out = **in
+	}
+	if in.ActiveDirectoryAuthorityHost != nil {
+		in, out := &in.ActiveDirectoryAuthorityHost, &out.ActiveDirectoryAuthorityHost
+		*out = new(string)
+		**out = **in
+	}
 	if in.ZonesCacheDuration != nil {
 		in, out := &in.ZonesCacheDuration, &out.ZonesCacheDuration
 		*out = new(time.Duration)
@@ -156,6 +269,84 @@ func (in *AzureProvider) DeepCopy() *AzureProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRDConfig) DeepCopyInto(out *CRDConfig) {
+	*out = *in
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.AnnotationFilter != nil {
+		in, out := &in.AnnotationFilter, &out.AnnotationFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelFilter != nil {
+		in, out := &in.LabelFilter, &out.LabelFilter
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRDConfig.
+func (in *CRDConfig) DeepCopy() *CRDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CRDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoProvider) DeepCopyInto(out *CivoProvider) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TokenSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoProvider.
+func (in *CivoProvider) DeepCopy() *CivoProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudMapRecord) DeepCopyInto(out *CloudMapRecord) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudMapRecord.
+func (in *CloudMapRecord) DeepCopy() *CloudMapRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudMapRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudflareProvider) DeepCopyInto(out *CloudflareProvider) {
 	*out = *in
@@ -224,103 +415,318 @@ func (in *CloudflareSecretReference) DeepCopy() *CloudflareSecretReference {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+func (in *CoreDNSProvider) DeepCopyInto(out *CoreDNSProvider) {
 	*out = *in
+	if in.EtcdURLs != nil {
+		in, out := &in.EtcdURLs, &out.EtcdURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSServerName != nil {
+		in, out := &in.TLSServerName, &out.TLSServerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSInsecure != nil {
+		in, out := &in.TLSInsecure, &out.TLSInsecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CoreDNSSecretReference)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
-func (in *DNSRecord) DeepCopy() *DNSRecord {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSProvider.
+func (in *CoreDNSProvider) DeepCopy() *CoreDNSProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSRecord)
+	out := new(CoreDNSProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalDNS) DeepCopyInto(out *ExternalDNS) {
+func (in *CoreDNSSecretReference) DeepCopyInto(out *CoreDNSSecretReference) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.TLSSecretKeys = in.TLSSecretKeys
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNS.
-func (in *ExternalDNS) DeepCopy() *ExternalDNS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSSecretReference.
+func (in *CoreDNSSecretReference) DeepCopy() *CoreDNSSecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalDNS)
+	out := new(CoreDNSSecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExternalDNS) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChange) DeepCopyInto(out *DNSChange) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.ProviderSpecific != nil {
+		in, out := &in.ProviderSpecific, &out.ProviderSpecific
+		*out = make([]ProviderSpecificProperty, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChange.
+func (in *DNSChange) DeepCopy() *DNSChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChange)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalDNSList) DeepCopyInto(out *ExternalDNSList) {
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ExternalDNS, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CloudMap != nil {
+		in, out := &in.CloudMap, &out.CloudMap
+		*out = new(CloudMapRecord)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSList.
-func (in *ExternalDNSList) DeepCopy() *ExternalDNSList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalDNSList)
+	out := new(DNSRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExternalDNSList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSimpleProvider) DeepCopyInto(out *DNSimpleProvider) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
 	}
-	return nil
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TokenSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSimpleProvider.
+func (in *DNSimpleProvider) DeepCopy() *DNSimpleProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSimpleProvider)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalDNSSpec) DeepCopyInto(out *ExternalDNSSpec) {
+func (in *DigitalOceanProvider) DeepCopyInto(out *DigitalOceanProvider) {
 	*out = *in
-	if in.RequestTimeout != nil {
-		in, out := &in.RequestTimeout, &out.RequestTimeout
-		*out = new(time.Duration)
+	if in.APIPageSize != nil {
+		in, out := &in.APIPageSize, &out.APIPageSize
+		*out = new(int)
 		**out = **in
 	}
-	in.Source.DeepCopyInto(&out.Source)
-	if in.OCRouterName != nil {
-		in, out := &in.OCRouterName, &out.OCRouterName
-		*out = new(string)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TokenSecretReference)
 		**out = **in
 	}
-	if in.GatewayNamespace != nil {
-		in, out := &in.GatewayNamespace, &out.GatewayNamespace
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigitalOceanProvider.
+func (in *DigitalOceanProvider) DeepCopy() *DigitalOceanProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DigitalOceanProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoDBRegistry) DeepCopyInto(out *DynamoDBRegistry) {
+	*out = *in
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
 		*out = new(string)
 		**out = **in
 	}
-	if in.GatewayLabelFilter != nil {
-		in, out := &in.GatewayLabelFilter, &out.GatewayLabelFilter
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateTable != nil {
+		in, out := &in.CreateTable, &out.CreateTable
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoDBRegistry.
+func (in *DynamoDBRegistry) DeepCopy() *DynamoDBRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoDBRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExoscaleProvider) DeepCopyInto(out *ExoscaleProvider) {
+	*out = *in
+	if in.APIEnvironment != nil {
+		in, out := &in.APIEnvironment, &out.APIEnvironment
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIZone != nil {
+		in, out := &in.APIZone, &out.APIZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(APIKeySecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExoscaleProvider.
+func (in *ExoscaleProvider) DeepCopy() *ExoscaleProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ExoscaleProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNS) DeepCopyInto(out *ExternalDNS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNS.
+func (in *ExternalDNS) DeepCopy() *ExternalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalDNS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSList) DeepCopyInto(out *ExternalDNSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalDNS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSList.
+func (in *ExternalDNSList) DeepCopy() *ExternalDNSList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalDNSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSSpec) DeepCopyInto(out *ExternalDNSSpec) {
+	*out = *in
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(time.Duration)
+		**out = **in
+	}
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]SourceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OCRouterName != nil {
+		in, out := &in.OCRouterName, &out.OCRouterName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayNamespace != nil {
+		in, out := &in.GatewayNamespace, &out.GatewayNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayLabelFilter != nil {
+		in, out := &in.GatewayLabelFilter, &out.GatewayLabelFilter
 		*out = new(string)
 		**out = **in
 	}
@@ -374,15 +780,140 @@ func (in *ExternalDNSSpec) DeepCopyInto(out *ExternalDNSSpec) {
 		*out = new(GoogleProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(RFC2136Provider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDNS != nil {
+		in, out := &in.PDNS, &out.PDNS
+		*out = new(PDNSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CoreDNS != nil {
+		in, out := &in.CoreDNS, &out.CoreDNS
+		*out = new(CoreDNSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DigitalOcean != nil {
+		in, out := &in.DigitalOcean, &out.DigitalOcean
+		*out = new(DigitalOceanProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Linode != nil {
+		in, out := &in.Linode, &out.Linode
+		*out = new(LinodeProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scaleway != nil {
+		in, out := &in.Scaleway, &out.Scaleway
+		*out = new(ScalewayProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Civo != nil {
+		in, out := &in.Civo, &out.Civo
+		*out = new(CivoProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSimple != nil {
+		in, out := &in.DNSimple, &out.DNSimple
+		*out = new(DNSimpleProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(GandiProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoDaddy != nil {
+		in, out := &in.GoDaddy, &out.GoDaddy
+		*out = new(GoDaddyProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Akamai != nil {
+		in, out := &in.Akamai, &out.Akamai
+		*out = new(AkamaiProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pihole != nil {
+		in, out := &in.Pihole, &out.Pihole
+		*out = new(PiholeProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NS1 != nil {
+		in, out := &in.NS1, &out.NS1
+		*out = new(NS1Provider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OVH != nil {
+		in, out := &in.OVH, &out.OVH
+		*out = new(OVHProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransIP != nil {
+		in, out := &in.TransIP, &out.TransIP
+		*out = new(TransIPProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exoscale != nil {
+		in, out := &in.Exoscale, &out.Exoscale
+		*out = new(ExoscaleProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlibabaCloud != nil {
+		in, out := &in.AlibabaCloud, &out.AlibabaCloud
+		*out = new(AlibabaCloudProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InMemory != nil {
+		in, out := &in.InMemory, &out.InMemory
+		*out = new(InMemoryProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalPolicy)
+		**out = **in
+	}
+	if in.Safety != nil {
+		in, out := &in.Safety, &out.Safety
+		*out = new(SafetyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Policy != nil {
 		in, out := &in.Policy, &out.Policy
 		*out = new(Policy)
 		**out = **in
 	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
 	if in.Registry != nil {
 		in, out := &in.Registry, &out.Registry
-		*out = new(string)
-		**out = **in
+		*out = new(Registry)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TXTOwnerID != nil {
 		in, out := &in.TXTOwnerID, &out.TXTOwnerID
@@ -430,7 +961,29 @@ func (in *ExternalDNSStatus) DeepCopyInto(out *ExternalDNSStatus) {
 	if in.DNSRecords != nil {
 		in, out := &in.DNSRecords, &out.DNSRecords
 		*out = make([]DNSRecord, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextSyncTime != nil {
+		in, out := &in.NextSyncTime, &out.NextSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = new(PendingChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InMemoryRecords != nil {
+		in, out := &in.InMemoryRecords, &out.InMemoryRecords
+		*out = make([]InMemoryRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -446,64 +999,33 @@ func (in *ExternalDNSStatus) DeepCopy() *ExternalDNSStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GenericSecretReference) DeepCopyInto(out *GenericSecretReference) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericSecretReference.
-func (in *GenericSecretReference) DeepCopy() *GenericSecretReference {
-	if in == nil {
-		return nil
-	}
-	out := new(GenericSecretReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GoogleProvider) DeepCopyInto(out *GoogleProvider) {
+func (in *GandiProvider) DeepCopyInto(out *GandiProvider) {
 	*out = *in
-	if in.Project != nil {
-		in, out := &in.Project, &out.Project
-		*out = new(string)
-		**out = **in
-	}
-	if in.BatchChangeSize != nil {
-		in, out := &in.BatchChangeSize, &out.BatchChangeSize
-		*out = new(int)
-		**out = **in
-	}
-	if in.BatchChangeInterval != nil {
-		in, out := &in.BatchChangeInterval, &out.BatchChangeInterval
-		*out = new(time.Duration)
-		**out = **in
-	}
-	if in.ZoneVisibility != nil {
-		in, out := &in.ZoneVisibility, &out.ZoneVisibility
+	if in.SharingID != nil {
+		in, out := &in.SharingID, &out.SharingID
 		*out = new(string)
 		**out = **in
 	}
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(GenericSecretReference)
+		*out = new(TokenSecretReference)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleProvider.
-func (in *GoogleProvider) DeepCopy() *GoogleProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GandiProvider.
+func (in *GandiProvider) DeepCopy() *GandiProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(GoogleProvider)
+	out := new(GandiProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
+func (in *GatewayRouteConfig) DeepCopyInto(out *GatewayRouteConfig) {
 	*out = *in
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
@@ -535,42 +1057,331 @@ func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.IgnoreIngressTLSSpec != nil {
-		in, out := &in.IgnoreIngressTLSSpec, &out.IgnoreIngressTLSSpec
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IgnoreIngressRulesSpec != nil {
-		in, out := &in.IgnoreIngressRulesSpec, &out.IgnoreIngressRulesSpec
-		*out = new(bool)
+	if in.GatewayName != nil {
+		in, out := &in.GatewayName, &out.GatewayName
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressConfig.
-func (in *IngressConfig) DeepCopy() *IngressConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRouteConfig.
+func (in *GatewayRouteConfig) DeepCopy() *GatewayRouteConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressConfig)
+	out := new(GatewayRouteConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
+func (in *GenericSecretReference) DeepCopyInto(out *GenericSecretReference) {
 	*out = *in
-	if in.AnnotationFilter != nil {
-		in, out := &in.AnnotationFilter, &out.AnnotationFilter
-		*out = new(string)
-		**out = **in
-	}
-	if in.LabelFilter != nil {
-		in, out := &in.LabelFilter, &out.LabelFilter
-		*out = new(string)
-		**out = **in
-	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericSecretReference.
+func (in *GenericSecretReference) DeepCopy() *GenericSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoDaddyProvider) DeepCopyInto(out *GoDaddyProvider) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OTE != nil {
+		in, out := &in.OTE, &out.OTE
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(APIKeySecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoDaddyProvider.
+func (in *GoDaddyProvider) DeepCopy() *GoDaddyProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoDaddyProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleProvider) DeepCopyInto(out *GoogleProvider) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.BatchChangeSize != nil {
+		in, out := &in.BatchChangeSize, &out.BatchChangeSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.BatchChangeInterval != nil {
+		in, out := &in.BatchChangeInterval, &out.BatchChangeInterval
+		*out = new(time.Duration)
+		**out = **in
+	}
+	if in.ZoneVisibility != nil {
+		in, out := &in.ZoneVisibility, &out.ZoneVisibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(GenericSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleProvider.
+func (in *GoogleProvider) DeepCopy() *GoogleProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryProvider) DeepCopyInto(out *InMemoryProvider) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryProvider.
+func (in *InMemoryProvider) DeepCopy() *InMemoryProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryRecord) DeepCopyInto(out *InMemoryRecord) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryRecord.
+func (in *InMemoryRecord) DeepCopy() *InMemoryRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreHostnameAnnotation != nil {
+		in, out := &in.IgnoreHostnameAnnotation, &out.IgnoreHostnameAnnotation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CombineFQDNAndAnnotation != nil {
+		in, out := &in.CombineFQDNAndAnnotation, &out.CombineFQDNAndAnnotation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AnnotationFilter != nil {
+		in, out := &in.AnnotationFilter, &out.AnnotationFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelFilter != nil {
+		in, out := &in.LabelFilter, &out.LabelFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.FQDNTemplate != nil {
+		in, out := &in.FQDNTemplate, &out.FQDNTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreIngressTLSSpec != nil {
+		in, out := &in.IgnoreIngressTLSSpec, &out.IgnoreIngressTLSSpec
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreIngressRulesSpec != nil {
+		in, out := &in.IgnoreIngressRulesSpec, &out.IgnoreIngressRulesSpec
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressConfig.
+func (in *IngressConfig) DeepCopy() *IngressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioConfig) DeepCopyInto(out *IstioConfig) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreHostnameAnnotation != nil {
+		in, out := &in.IgnoreHostnameAnnotation, &out.IgnoreHostnameAnnotation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CombineFQDNAndAnnotation != nil {
+		in, out := &in.CombineFQDNAndAnnotation, &out.CombineFQDNAndAnnotation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AnnotationFilter != nil {
+		in, out := &in.AnnotationFilter, &out.AnnotationFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelFilter != nil {
+		in, out := &in.LabelFilter, &out.LabelFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.FQDNTemplate != nil {
+		in, out := &in.FQDNTemplate, &out.FQDNTemplate
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioConfig.
+func (in *IstioConfig) DeepCopy() *IstioConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeProvider) DeepCopyInto(out *LinodeProvider) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TokenSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeProvider.
+func (in *LinodeProvider) DeepCopy() *LinodeProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NS1Provider) DeepCopyInto(out *NS1Provider) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreSSL != nil {
+		in, out := &in.IgnoreSSL, &out.IgnoreSSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinTTLSeconds != nil {
+		in, out := &in.MinTTLSeconds, &out.MinTTLSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TokenSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NS1Provider.
+func (in *NS1Provider) DeepCopy() *NS1Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(NS1Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
+	*out = *in
+	if in.AnnotationFilter != nil {
+		in, out := &in.AnnotationFilter, &out.AnnotationFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelFilter != nil {
+		in, out := &in.LabelFilter, &out.LabelFilter
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -579,7 +1390,462 @@ func (in *NodeConfig) DeepCopy() *NodeConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeConfig)
+	out := new(NodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIProvider) DeepCopyInto(out *OCIProvider) {
+	*out = *in
+	if in.AuthMode != nil {
+		in, out := &in.AuthMode, &out.AuthMode
+		*out = new(OCIAuthMode)
+		**out = **in
+	}
+	if in.CompartmentOCID != nil {
+		in, out := &in.CompartmentOCID, &out.CompartmentOCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneScope != nil {
+		in, out := &in.ZoneScope, &out.ZoneScope
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneCacheDuration != nil {
+		in, out := &in.ZoneCacheDuration, &out.ZoneCacheDuration
+		*out = new(time.Duration)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(OCISecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIProvider.
+func (in *OCIProvider) DeepCopy() *OCIProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISecretReference) DeepCopyInto(out *OCISecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISecretReference.
+func (in *OCISecretReference) DeepCopy() *OCISecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVHProvider) DeepCopyInto(out *OVHProvider) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIRateLimit != nil {
+		in, out := &in.APIRateLimit, &out.APIRateLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.EnableCNAMERelative != nil {
+		in, out := &in.EnableCNAMERelative, &out.EnableCNAMERelative
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(OVHSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVHProvider.
+func (in *OVHProvider) DeepCopy() *OVHProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OVHProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVHSecretReference) DeepCopyInto(out *OVHSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVHSecretReference.
+func (in *OVHSecretReference) DeepCopy() *OVHSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OVHSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDNSProvider) DeepCopyInto(out *PDNSProvider) {
+	*out = *in
+	if in.ServerID != nil {
+		in, out := &in.ServerID, &out.ServerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipTLSVerify != nil {
+		in, out := &in.SkipTLSVerify, &out.SkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(PDNSSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDNSProvider.
+func (in *PDNSProvider) DeepCopy() *PDNSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(PDNSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDNSSecretReference) DeepCopyInto(out *PDNSSecretReference) {
+	*out = *in
+	out.TLSSecretKeys = in.TLSSecretKeys
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDNSSecretReference.
+func (in *PDNSSecretReference) DeepCopy() *PDNSSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PDNSSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingChanges) DeepCopyInto(out *PendingChanges) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = make([]DNSChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateOld != nil {
+		in, out := &in.UpdateOld, &out.UpdateOld
+		*out = make([]DNSChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateNew != nil {
+		in, out := &in.UpdateNew, &out.UpdateNew
+		*out = make([]DNSChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Delete != nil {
+		in, out := &in.Delete, &out.Delete
+		*out = make([]DNSChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingChanges.
+func (in *PendingChanges) DeepCopy() *PendingChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PiholeProvider) DeepCopyInto(out *PiholeProvider) {
+	*out = *in
+	if in.TLSInsecureSkipVerify != nil {
+		in, out := &in.TLSInsecureSkipVerify, &out.TLSInsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(PiholeSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PiholeProvider.
+func (in *PiholeProvider) DeepCopy() *PiholeProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(PiholeProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PiholeSecretReference) DeepCopyInto(out *PiholeSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PiholeSecretReference.
+func (in *PiholeSecretReference) DeepCopy() *PiholeSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PiholeSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderSpecificProperty) DeepCopyInto(out *ProviderSpecificProperty) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSpecificProperty.
+func (in *ProviderSpecificProperty) DeepCopy() *ProviderSpecificProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderSpecificProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RFC2136Provider) DeepCopyInto(out *RFC2136Provider) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSIGKeyName != nil {
+		in, out := &in.TSIGKeyName, &out.TSIGKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TSIGSecretAlg != nil {
+		in, out := &in.TSIGSecretAlg, &out.TSIGSecretAlg
+		*out = new(string)
+		**out = **in
+	}
+	if in.TAXFR != nil {
+		in, out := &in.TAXFR, &out.TAXFR
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinTTL != nil {
+		in, out := &in.MinTTL, &out.MinTTL
+		*out = new(time.Duration)
+		**out = **in
+	}
+	if in.BatchChangeSize != nil {
+		in, out := &in.BatchChangeSize, &out.BatchChangeSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.CreatePTR != nil {
+		in, out := &in.CreatePTR, &out.CreatePTR
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancingStrategy != nil {
+		in, out := &in.LoadBalancingStrategy, &out.LoadBalancingStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KerberosRealm != nil {
+		in, out := &in.KerberosRealm, &out.KerberosRealm
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseTLS != nil {
+		in, out := &in.UseTLS, &out.UseTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipTLSVerify != nil {
+		in, out := &in.SkipTLSVerify, &out.SkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(RFC2136SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RFC2136Provider.
+func (in *RFC2136Provider) DeepCopy() *RFC2136Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(RFC2136Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RFC2136SecretReference) DeepCopyInto(out *RFC2136SecretReference) {
+	*out = *in
+	out.TLSSecretKeys = in.TLSSecretKeys
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RFC2136SecretReference.
+func (in *RFC2136SecretReference) DeepCopy() *RFC2136SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RFC2136SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Registry) DeepCopyInto(out *Registry) {
+	*out = *in
+	if in.TXT != nil {
+		in, out := &in.TXT, &out.TXT
+		*out = new(TXTRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamoDB != nil {
+		in, out := &in.DynamoDB, &out.DynamoDB
+		*out = new(DynamoDBRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registry.
+func (in *Registry) DeepCopy() *Registry {
+	if in == nil {
+		return nil
+	}
+	out := new(Registry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SafetyConfig) DeepCopyInto(out *SafetyConfig) {
+	*out = *in
+	if in.MaxDeletesPerSync != nil {
+		in, out := &in.MaxDeletesPerSync, &out.MaxDeletesPerSync
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SafetyConfig.
+func (in *SafetyConfig) DeepCopy() *SafetyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SafetyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayProvider) DeepCopyInto(out *ScalewayProvider) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.PageSize != nil {
+		in, out := &in.PageSize, &out.PageSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(APIKeySecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayProvider.
+func (in *ScalewayProvider) DeepCopy() *ScalewayProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayProvider)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -674,6 +1940,21 @@ func (in *SourceConfig) DeepCopyInto(out *SourceConfig) {
 		*out = new(IngressConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GatewayRoute != nil {
+		in, out := &in.GatewayRoute, &out.GatewayRoute
+		*out = new(GatewayRouteConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(IstioConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CRD != nil {
+		in, out := &in.CRD, &out.CRD
+		*out = new(CRDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -687,6 +1968,134 @@ func (in *SourceConfig) DeepCopy() *SourceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecretKeys) DeepCopyInto(out *TLSSecretKeys) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretKeys.
+func (in *TLSSecretKeys) DeepCopy() *TLSSecretKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecretKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TXTEncryption) DeepCopyInto(out *TXTEncryption) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TXTEncryption.
+func (in *TXTEncryption) DeepCopy() *TXTEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(TXTEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TXTEncryptionSecretReference) DeepCopyInto(out *TXTEncryptionSecretReference) {
+	*out = *in
+	if in.OldAESKeyKey != nil {
+		in, out := &in.OldAESKeyKey, &out.OldAESKeyKey
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TXTEncryptionSecretReference.
+func (in *TXTEncryptionSecretReference) DeepCopy() *TXTEncryptionSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TXTEncryptionSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TXTRegistry) DeepCopyInto(out *TXTRegistry) {
+	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(TXTEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TXTRegistry.
+func (in *TXTRegistry) DeepCopy() *TXTRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(TXTRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenSecretReference) DeepCopyInto(out *TokenSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenSecretReference.
+func (in *TokenSecretReference) DeepCopy() *TokenSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransIPProvider) DeepCopyInto(out *TransIPProvider) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TransIPSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransIPProvider.
+func (in *TransIPProvider) DeepCopy() *TransIPProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(TransIPProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransIPSecretReference) DeepCopyInto(out *TransIPSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransIPSecretReference.
+func (in *TransIPSecretReference) DeepCopy() *TransIPSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TransIPSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TypeInfo) DeepCopyInto(out *TypeInfo) {
 	*out = *in
@@ -702,3 +2111,102 @@ func (in *TypeInfo) DeepCopy() *TypeInfo {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookProvider) DeepCopyInto(out *WebhookProvider) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadTimeout != nil {
+		in, out := &in.ReadTimeout, &out.ReadTimeout
+		*out = new(time.Duration)
+		**out = **in
+	}
+	if in.WriteTimeout != nil {
+		in, out := &in.WriteTimeout, &out.WriteTimeout
+		*out = new(time.Duration)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(WebhookSecretReference)
+		**out = **in
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(WebhookServer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookProvider.
+func (in *WebhookProvider) DeepCopy() *WebhookProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSecretReference) DeepCopyInto(out *WebhookSecretReference) {
+	*out = *in
+	out.TLSSecretKeys = in.TLSSecretKeys
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSecretReference.
+func (in *WebhookSecretReference) DeepCopy() *WebhookSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookServer) DeepCopyInto(out *WebhookServer) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookServer.
+func (in *WebhookServer) DeepCopy() *WebhookServer {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookServer)
+	in.DeepCopyInto(out)
+	return out
+}
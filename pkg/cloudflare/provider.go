@@ -0,0 +1,973 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudflare implements the Cloudflare provider. It follows the upstream cloudflare provider, but takes the
+// API token, or the API key and email, and the API URL from the caller instead of the environment, so every
+// ExternalDNS calls Cloudflare with its own credentials. The API services are built from these options only, the
+// CLOUDFLARE_* variables read by the default client of the SDK are ignored.
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	cloudflarev0 "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/cloudflare-go/v5"
+	"github.com/cloudflare/cloudflare-go/v5/addressing"
+	"github.com/cloudflare/cloudflare-go/v5/custom_hostnames"
+	"github.com/cloudflare/cloudflare-go/v5/dns"
+	"github.com/cloudflare/cloudflare-go/v5/option"
+	"github.com/cloudflare/cloudflare-go/v5/zones"
+	"golang.org/x/net/publicsuffix"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/source/annotations"
+)
+
+type changeAction int
+
+const (
+	cloudFlareCreate changeAction = iota
+	cloudFlareDelete
+	cloudFlareUpdate
+)
+
+const (
+	// defaultTTL 1 is the automatic TTL of Cloudflare
+	defaultTTL = 1
+
+	// the comment length limits of the Cloudflare plans, https://developers.cloudflare.com/dns/manage-dns-records/reference/record-attributes/#availability
+	freeZoneMaxCommentLength = 100
+	paidZoneMaxCommentLength = 500
+)
+
+var changeActionNames = map[changeAction]string{
+	cloudFlareCreate: "CREATE",
+	cloudFlareDelete: "DELETE",
+	cloudFlareUpdate: "UPDATE",
+}
+
+func (action changeAction) String() string {
+	return changeActionNames[action]
+}
+
+var recordTypeProxyNotSupported = map[string]bool{
+	"LOC": true,
+	"MX":  true,
+	"NS":  true,
+	"SPF": true,
+	"TXT": true,
+	"SRV": true,
+}
+
+var recordTypeCustomHostnameSupported = map[string]bool{
+	"A":     true,
+	"CNAME": true,
+}
+
+// dnsRecordIndex is the key of a record, to find its id
+type dnsRecordIndex struct {
+	Name    string
+	Type    string
+	Content string
+}
+
+type dnsRecordsMap map[dnsRecordIndex]dns.RecordResponse
+
+// customHostnamesMap holds the custom hostnames of a zone, keyed by their hostname
+type customHostnamesMap map[string]cloudflarev0.CustomHostname
+
+// autoPager iterates over the pages of a list
+type autoPager[T any] interface {
+	Next() bool
+	Current() T
+	Err() error
+}
+
+// autoPagerIterator returns an iterator over the items of iter
+func autoPagerIterator[T any](iter autoPager[T]) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for iter.Next() {
+			if !yield(iter.Current()) {
+				return
+			}
+		}
+	}
+}
+
+// client is the subset of the Cloudflare API used by the provider
+type client interface {
+	ZoneIDByName(ctx context.Context, zoneName string) (string, error)
+	ListZones(ctx context.Context, params zones.ZoneListParams) autoPager[zones.Zone]
+	GetZone(ctx context.Context, zoneID string) (*zones.Zone, error)
+	ListDNSRecords(ctx context.Context, params dns.RecordListParams) autoPager[dns.RecordResponse]
+	CreateDNSRecord(ctx context.Context, params dns.RecordNewParams) (*dns.RecordResponse, error)
+	DeleteDNSRecord(ctx context.Context, recordID string, params dns.RecordDeleteParams) error
+	UpdateDNSRecord(ctx context.Context, recordID string, params dns.RecordUpdateParams) (*dns.RecordResponse, error)
+	ListDataLocalizationRegionalHostnames(ctx context.Context, params addressing.RegionalHostnameListParams) autoPager[addressing.RegionalHostnameListResponse]
+	CreateDataLocalizationRegionalHostname(ctx context.Context, params addressing.RegionalHostnameNewParams) error
+	UpdateDataLocalizationRegionalHostname(ctx context.Context, hostname string, params addressing.RegionalHostnameEditParams) error
+	DeleteDataLocalizationRegionalHostname(ctx context.Context, hostname string, params addressing.RegionalHostnameDeleteParams) error
+	CustomHostnames(ctx context.Context, zoneID string, page int, filter cloudflarev0.CustomHostname) ([]cloudflarev0.CustomHostname, cloudflarev0.ResultInfo, error)
+	DeleteCustomHostname(ctx context.Context, customHostnameID string, params custom_hostnames.CustomHostnameDeleteParams) error
+	CreateCustomHostname(ctx context.Context, zoneID string, ch cloudflarev0.CustomHostname) (*cloudflarev0.CustomHostnameResponse, error)
+}
+
+// zoneService calls the Cloudflare API. The custom hostnames are still managed with the v0 API, like upstream.
+type zoneService struct {
+	serviceV0         *cloudflarev0.API
+	zones             *zones.ZoneService
+	records           *dns.RecordService
+	regionalHostnames *addressing.RegionalHostnameService
+	customHostnames   *custom_hostnames.CustomHostnameService
+}
+
+func (z zoneService) ZoneIDByName(ctx context.Context, zoneName string) (string, error) {
+	iter := z.zones.ListAutoPaging(ctx, zones.ZoneListParams{Name: cloudflare.F(zoneName)})
+	for zone := range autoPagerIterator(iter) {
+		if zone.Name == zoneName {
+			return zone.ID, nil
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return "", fmt.Errorf("failed to list zones from CloudFlare API: %w", err)
+	}
+	return "", fmt.Errorf("zone %q not found in CloudFlare account - verify the zone exists and API credentials have access to it", zoneName)
+}
+
+func (z zoneService) ListZones(ctx context.Context, params zones.ZoneListParams) autoPager[zones.Zone] {
+	return z.zones.ListAutoPaging(ctx, params)
+}
+
+func (z zoneService) GetZone(ctx context.Context, zoneID string) (*zones.Zone, error) {
+	return z.zones.Get(ctx, zones.ZoneGetParams{ZoneID: cloudflare.F(zoneID)})
+}
+
+func (z zoneService) ListDNSRecords(ctx context.Context, params dns.RecordListParams) autoPager[dns.RecordResponse] {
+	return z.records.ListAutoPaging(ctx, params)
+}
+
+func (z zoneService) CreateDNSRecord(ctx context.Context, params dns.RecordNewParams) (*dns.RecordResponse, error) {
+	return z.records.New(ctx, params)
+}
+
+func (z zoneService) UpdateDNSRecord(ctx context.Context, recordID string, params dns.RecordUpdateParams) (*dns.RecordResponse, error) {
+	return z.records.Update(ctx, recordID, params)
+}
+
+func (z zoneService) DeleteDNSRecord(ctx context.Context, recordID string, params dns.RecordDeleteParams) error {
+	_, err := z.records.Delete(ctx, recordID, params)
+	return err
+}
+
+func (z zoneService) CustomHostnames(ctx context.Context, zoneID string, page int, filter cloudflarev0.CustomHostname) ([]cloudflarev0.CustomHostname, cloudflarev0.ResultInfo, error) {
+	return z.serviceV0.CustomHostnames(ctx, zoneID, page, filter)
+}
+
+func (z zoneService) DeleteCustomHostname(ctx context.Context, customHostnameID string, params custom_hostnames.CustomHostnameDeleteParams) error {
+	_, err := z.customHostnames.Delete(ctx, customHostnameID, params)
+	return err
+}
+
+func (z zoneService) CreateCustomHostname(ctx context.Context, zoneID string, ch cloudflarev0.CustomHostname) (*cloudflarev0.CustomHostnameResponse, error) {
+	return z.serviceV0.CreateCustomHostname(ctx, zoneID, ch)
+}
+
+// CustomHostnamesConfig configures the custom hostnames of the records, https://developers.cloudflare.com/cloudflare-for-platforms/cloudflare-for-saas/
+type CustomHostnamesConfig struct {
+	Enabled              bool
+	MinTLSVersion        string
+	CertificateAuthority string
+}
+
+// DNSRecordsConfig configures the listing and the comment of the records
+type DNSRecordsConfig struct {
+	PerPage int
+	Comment string
+}
+
+// Config configures the Cloudflare provider. It authenticates with the API token, or else with the API key and email.
+type Config struct {
+	APIToken string
+	APIKey   string
+	APIEmail string
+
+	// BaseURL overrides the URL of the Cloudflare API
+	BaseURL string
+
+	ProxiedByDefault       bool
+	RegionalServicesConfig RegionalServicesConfig
+	CustomHostnamesConfig  CustomHostnamesConfig
+	DNSRecordsConfig       DNSRecordsConfig
+
+	DomainFilter *endpoint.DomainFilter
+	ZoneIDFilter provider.ZoneIDFilter
+	DryRun       bool
+}
+
+// Provider manages the records of the Cloudflare zones
+type Provider struct {
+	provider.BaseProvider
+	client                 client
+	domainFilter           *endpoint.DomainFilter
+	zoneIDFilter           provider.ZoneIDFilter
+	proxiedByDefault       bool
+	dryRun                 bool
+	customHostnamesConfig  CustomHostnamesConfig
+	dnsRecordsConfig       DNSRecordsConfig
+	regionalServicesConfig RegionalServicesConfig
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns a Cloudflare provider calling the API with the credentials of config
+func NewProvider(config Config) (*Provider, error) {
+	var v0Opts []cloudflarev0.Option
+	opts := []option.RequestOption{option.WithEnvironmentProduction()}
+	if config.BaseURL != "" {
+		v0Opts = append(v0Opts, cloudflarev0.BaseURL(config.BaseURL))
+		opts = append(opts, option.WithBaseURL(config.BaseURL))
+	}
+
+	var (
+		v0  *cloudflarev0.API
+		err error
+	)
+	switch {
+	case config.APIToken != "":
+		v0, err = cloudflarev0.NewWithAPIToken(config.APIToken, v0Opts...)
+		opts = append(opts, option.WithAPIToken(config.APIToken))
+	case config.APIKey != "" && config.APIEmail != "":
+		v0, err = cloudflarev0.New(config.APIKey, config.APIEmail, v0Opts...)
+		opts = append(opts, option.WithAPIKey(config.APIKey), option.WithAPIEmail(config.APIEmail))
+	default:
+		return nil, errors.New("no api token, or api key and email, found for cloudflare provider")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cloudflare provider: %w", err)
+	}
+
+	return newProvider(zoneService{
+		serviceV0:         v0,
+		zones:             zones.NewZoneService(opts...),
+		records:           dns.NewRecordService(opts...),
+		regionalHostnames: addressing.NewRegionalHostnameService(opts...),
+		customHostnames:   custom_hostnames.NewCustomHostnameService(opts...),
+	}, config), nil
+}
+
+func newProvider(c client, config Config) *Provider {
+	if config.RegionalServicesConfig.RegionKey != "" {
+		config.RegionalServicesConfig.Enabled = true
+	}
+	return &Provider{
+		client:                 c,
+		domainFilter:           config.DomainFilter,
+		zoneIDFilter:           config.ZoneIDFilter,
+		proxiedByDefault:       config.ProxiedByDefault,
+		dryRun:                 config.DryRun,
+		customHostnamesConfig:  config.CustomHostnamesConfig,
+		dnsRecordsConfig:       config.DNSRecordsConfig,
+		regionalServicesConfig: config.RegionalServicesConfig,
+	}
+}
+
+// convertCloudflareError turns the rate limit and the server errors into soft errors
+func convertCloudflareError(err error) error {
+	var apiErr *cloudflarev0.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.ClientRateLimited() || apiErr.StatusCode >= http.StatusInternalServerError {
+			return provider.NewSoftError(err)
+		}
+	}
+	// the v5 library returns no typed error once its rate limit retries are exhausted
+	if strings.Contains(err.Error(), "exceeded available rate limit retries") {
+		return provider.NewSoftError(err)
+	}
+	return err
+}
+
+// Zones returns the zones of the zone id filter, or else the zones matching the domain filter
+func (p *Provider) Zones(ctx context.Context) ([]zones.Zone, error) {
+	var result []zones.Zone
+
+	if len(p.zoneIDFilter.ZoneIDs) > 0 && p.zoneIDFilter.ZoneIDs[0] != "" {
+		for _, zoneID := range p.zoneIDFilter.ZoneIDs {
+			zone, err := p.client.GetZone(ctx, zoneID)
+			if err != nil {
+				klog.Errorf("failed to look up cloudflare zone %q: %v", zoneID, err)
+				return result, convertCloudflareError(err)
+			}
+			klog.V(4).InfoS("adding zone for consideration", "zoneName", zone.Name, "zoneID", zone.ID)
+			result = append(result, *zone)
+		}
+		return result, nil
+	}
+
+	iter := p.client.ListZones(ctx, zones.ZoneListParams{})
+	for zone := range autoPagerIterator(iter) {
+		if !p.domainFilter.Match(zone.Name) {
+			klog.V(4).Infof("zone %q not in domain filter", zone.Name)
+			continue
+		}
+		klog.V(4).InfoS("adding zone for consideration", "zoneName", zone.Name, "zoneID", zone.ID)
+		result = append(result, zone)
+	}
+	if iter.Err() != nil {
+		return nil, convertCloudflareError(iter.Err())
+	}
+	return result, nil
+}
+
+// Records returns the records of the zones. Cloudflare has a record for every target, the records of a name and
+// type are grouped into one endpoint so the plan compares the sets of targets.
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []*endpoint.Endpoint
+	for _, zone := range zones {
+		records, err := p.getDNSRecordsMap(ctx, zone.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// nil if custom hostnames are not enabled
+		chs, err := p.listCustomHostnamesWithPagination(ctx, zone.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		zoneEndpoints := p.groupByNameAndTypeWithCustomHostnames(records, chs)
+		if err := p.addEndpointsProviderSpecificRegionKeyProperty(ctx, zone.ID, zoneEndpoints); err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, zoneEndpoints...)
+	}
+	return endpoints, nil
+}
+
+// ApplyChanges creates, updates and deletes the records of changes, a record for every target
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	var cloudflareChanges []*cloudFlareChange
+	add := func(action changeAction, ep *endpoint.Endpoint, target string, current *endpoint.Endpoint) {
+		change, err := p.newCloudFlareChange(ctx, action, ep, target, current)
+		if err != nil {
+			klog.Errorf("failed to create cloudflare change: %v", err)
+			return
+		}
+		cloudflareChanges = append(cloudflareChanges, change)
+	}
+	deleteAll := func() {
+		for _, e := range changes.Delete {
+			for _, target := range e.Targets {
+				add(cloudFlareDelete, e, target, nil)
+			}
+		}
+	}
+
+	// with custom hostnames, deleting first avoids conflicts with the new ones
+	if p.customHostnamesConfig.Enabled {
+		deleteAll()
+	}
+
+	for _, e := range changes.Create {
+		for _, target := range e.Targets {
+			add(cloudFlareCreate, e, target, nil)
+		}
+	}
+
+	for i, desired := range changes.UpdateNew {
+		current := changes.UpdateOld[i]
+
+		created, removed, kept := provider.Difference(current.Targets, desired.Targets)
+		for _, target := range removed {
+			add(cloudFlareDelete, current, target, current)
+		}
+		for _, target := range created {
+			add(cloudFlareCreate, desired, target, current)
+		}
+		for _, target := range kept {
+			add(cloudFlareUpdate, desired, target, current)
+		}
+	}
+
+	if !p.customHostnamesConfig.Enabled {
+		deleteAll()
+	}
+
+	return p.submitChanges(ctx, cloudflareChanges)
+}
+
+// AdjustEndpoints sets the provider specific properties of the endpoints to the values the records are listed with
+func (p *Provider) AdjustEndpoints(endpoints []*endpoint.Endpoint) ([]*endpoint.Endpoint, error) {
+	var adjusted []*endpoint.Endpoint
+	for _, e := range endpoints {
+		proxied := shouldBeProxied(e, p.proxiedByDefault)
+		if proxied {
+			e.RecordTTL = 0
+		}
+		e.SetProviderSpecificProperty(annotations.CloudflareProxiedKey, strconv.FormatBool(proxied))
+
+		if p.customHostnamesConfig.Enabled {
+			// sort the custom hostnames to detect their changes
+			if customHostnames := getEndpointCustomHostnames(e); len(customHostnames) > 1 {
+				sort.Strings(customHostnames)
+				e.SetProviderSpecificProperty(annotations.CloudflareCustomHostnameKey, strings.Join(customHostnames, ","))
+			}
+		} else {
+			e.DeleteProviderSpecificProperty(annotations.CloudflareCustomHostnameKey)
+		}
+
+		if val, ok := e.GetProviderSpecificProperty(annotations.CloudflareTagsKey); ok {
+			e.SetProviderSpecificProperty(annotations.CloudflareTagsKey, strings.Join(parseTagsAnnotation(val), ","))
+		}
+
+		p.adjustEndpointProviderSpecificRegionKeyProperty(e)
+
+		if p.dnsRecordsConfig.Comment != "" {
+			if _, found := e.GetProviderSpecificProperty(annotations.CloudflareRecordCommentKey); !found {
+				e.SetProviderSpecificProperty(annotations.CloudflareRecordCommentKey, p.dnsRecordsConfig.Comment)
+			}
+		}
+		adjusted = append(adjusted, e)
+	}
+	return adjusted, nil
+}
+
+// SupportedAdditionalRecordTypes returns true if the record type is supported by the provider
+func (p *Provider) SupportedAdditionalRecordTypes(recordType string) bool {
+	switch recordType {
+	case endpoint.RecordTypeMX:
+		return true
+	default:
+		return provider.SupportedRecordType(recordType)
+	}
+}
+
+// cloudFlareChange is a record to create, update or delete, with its custom and regional hostnames
+type cloudFlareChange struct {
+	Action              changeAction
+	ResourceRecord      dns.RecordResponse
+	RegionalHostname    regionalHostname
+	CustomHostnames     map[string]cloudflarev0.CustomHostname
+	CustomHostnamesPrev []string
+}
+
+func getUpdateDNSRecordParam(zoneID string, cfc *cloudFlareChange) dns.RecordUpdateParams {
+	return dns.RecordUpdateParams{
+		ZoneID: cloudflare.F(zoneID),
+		Body: dns.RecordUpdateParamsBody{
+			Name:     cloudflare.F(cfc.ResourceRecord.Name),
+			TTL:      cloudflare.F(cfc.ResourceRecord.TTL),
+			Proxied:  cloudflare.F(cfc.ResourceRecord.Proxied),
+			Type:     cloudflare.F(dns.RecordUpdateParamsBodyType(cfc.ResourceRecord.Type)),
+			Content:  cloudflare.F(cfc.ResourceRecord.Content),
+			Priority: cloudflare.F(cfc.ResourceRecord.Priority),
+			Comment:  cloudflare.F(cfc.ResourceRecord.Comment),
+			Tags:     cloudflare.F(cfc.ResourceRecord.Tags),
+		},
+	}
+}
+
+func getCreateDNSRecordParam(zoneID string, cfc *cloudFlareChange) dns.RecordNewParams {
+	return dns.RecordNewParams{
+		ZoneID: cloudflare.F(zoneID),
+		Body: dns.RecordNewParamsBody{
+			Name:     cloudflare.F(cfc.ResourceRecord.Name),
+			TTL:      cloudflare.F(cfc.ResourceRecord.TTL),
+			Proxied:  cloudflare.F(cfc.ResourceRecord.Proxied),
+			Type:     cloudflare.F(dns.RecordNewParamsBodyType(cfc.ResourceRecord.Type)),
+			Content:  cloudflare.F(cfc.ResourceRecord.Content),
+			Priority: cloudflare.F(cfc.ResourceRecord.Priority),
+			Comment:  cloudflare.F(cfc.ResourceRecord.Comment),
+			Tags:     cloudflare.F(cfc.ResourceRecord.Tags),
+		},
+	}
+}
+
+// submitCustomHostnameChanges applies the custom hostname changes of change, returns false if one of them fails
+func (p *Provider) submitCustomHostnameChanges(ctx context.Context, zoneID string, change *cloudFlareChange, chs customHostnamesMap, logger klog.Logger) bool {
+	if !p.customHostnamesConfig.Enabled || !recordTypeCustomHostnameSupported[string(change.ResourceRecord.Type)] {
+		return true
+	}
+
+	failedChange := false
+	deleteParams := custom_hostnames.CustomHostnameDeleteParams{ZoneID: cloudflare.F(zoneID)}
+	switch change.Action {
+	case cloudFlareUpdate:
+		add, remove, _ := provider.Difference(change.CustomHostnamesPrev, slices.Collect(maps.Keys(change.CustomHostnames)))
+		for _, name := range remove {
+			if prev, err := getCustomHostname(chs, name); err == nil && prev.ID != "" {
+				logger.Info("removing previous custom hostname", "id", prev.ID, "hostname", name)
+				if err := p.client.DeleteCustomHostname(ctx, prev.ID, deleteParams); err != nil {
+					failedChange = true
+					logger.Error(err, "failed to remove previous custom hostname", "id", prev.ID, "hostname", name)
+				}
+			}
+		}
+		for _, name := range add {
+			logger.Info("adding custom hostname", "hostname", name)
+			if _, err := p.client.CreateCustomHostname(ctx, zoneID, change.CustomHostnames[name]); err != nil {
+				failedChange = true
+				logger.Error(err, "failed to add custom hostname", "hostname", name)
+			}
+		}
+	case cloudFlareDelete:
+		for _, changeCH := range change.CustomHostnames {
+			if changeCH.Hostname == "" {
+				continue
+			}
+			logger.Info("deleting custom hostname", "hostname", changeCH.Hostname)
+			ch, err := getCustomHostname(chs, changeCH.Hostname)
+			if err != nil {
+				logger.Info("failed to delete custom hostname", "hostname", changeCH.Hostname, "err", err)
+				continue
+			}
+			if err := p.client.DeleteCustomHostname(ctx, ch.ID, deleteParams); err != nil {
+				failedChange = true
+				logger.Error(err, "failed to delete custom hostname", "id", ch.ID, "hostname", changeCH.Hostname)
+			}
+		}
+	case cloudFlareCreate:
+		for _, changeCH := range change.CustomHostnames {
+			if changeCH.Hostname == "" {
+				continue
+			}
+			logger.Info("creating custom hostname", "hostname", changeCH.Hostname)
+			if ch, err := getCustomHostname(chs, changeCH.Hostname); err == nil {
+				if changeCH.CustomOriginServer == ch.CustomOriginServer {
+					logger.Info("custom hostname already exists with the same origin", "hostname", changeCH.Hostname, "origin", ch.CustomOriginServer)
+				} else {
+					failedChange = true
+					logger.Error(nil, "failed to create custom hostname, it already exists with another origin", "hostname", changeCH.Hostname, "origin", ch.CustomOriginServer)
+				}
+				continue
+			}
+			if _, err := p.client.CreateCustomHostname(ctx, zoneID, changeCH); err != nil {
+				failedChange = true
+				logger.Error(err, "failed to create custom hostname", "hostname", changeCH.Hostname)
+			}
+		}
+	}
+	return !failedChange
+}
+
+// submitChanges applies changes zone by zone. The changes failing in a zone do not stop the others, the zones
+// with failed changes are returned in a soft error.
+func (p *Provider) submitChanges(ctx context.Context, changes []*cloudFlareChange) error {
+	if len(changes) == 0 {
+		klog.Info("all records are already up to date")
+		return nil
+	}
+
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return err
+	}
+
+	var failedZones []string
+	for zoneID, zoneChanges := range p.changesByZone(zones, changes) {
+		var failedChange bool
+
+		for _, change := range zoneChanges {
+			logger := klog.Background().WithValues(
+				"record", change.ResourceRecord.Name,
+				"type", change.ResourceRecord.Type,
+				"ttl", change.ResourceRecord.TTL,
+				"action", change.Action.String(),
+				"zone", zoneID,
+			)
+			logger.Info("changing record")
+			if p.dryRun {
+				continue
+			}
+
+			records, err := p.getDNSRecordsMap(ctx, zoneID)
+			if err != nil {
+				return fmt.Errorf("could not fetch records from zone, %w", err)
+			}
+			chs, err := p.listCustomHostnamesWithPagination(ctx, zoneID)
+			if err != nil {
+				return fmt.Errorf("could not fetch custom hostnames from zone, %w", err)
+			}
+
+			switch change.Action {
+			case cloudFlareUpdate:
+				if !p.submitCustomHostnameChanges(ctx, zoneID, change, chs, logger) {
+					failedChange = true
+				}
+				recordID := getRecordID(records, change.ResourceRecord)
+				if recordID == "" {
+					logger.Error(nil, "failed to find previous record")
+					continue
+				}
+				if _, err := p.client.UpdateDNSRecord(ctx, recordID, getUpdateDNSRecordParam(zoneID, change)); err != nil {
+					failedChange = true
+					logger.Error(err, "failed to update record")
+				}
+			case cloudFlareDelete:
+				recordID := getRecordID(records, change.ResourceRecord)
+				if recordID == "" {
+					logger.Error(nil, "failed to find previous record")
+					continue
+				}
+				if err := p.client.DeleteDNSRecord(ctx, recordID, dns.RecordDeleteParams{ZoneID: cloudflare.F(zoneID)}); err != nil {
+					failedChange = true
+					logger.Error(err, "failed to delete record")
+				}
+				if !p.submitCustomHostnameChanges(ctx, zoneID, change, chs, logger) {
+					failedChange = true
+				}
+			case cloudFlareCreate:
+				if _, err := p.client.CreateDNSRecord(ctx, getCreateDNSRecordParam(zoneID, change)); err != nil {
+					failedChange = true
+					logger.Error(err, "failed to create record")
+				}
+				if !p.submitCustomHostnameChanges(ctx, zoneID, change, chs, logger) {
+					failedChange = true
+				}
+			}
+		}
+
+		if p.regionalServicesConfig.Enabled {
+			desired, err := desiredRegionalHostnames(zoneChanges)
+			if err != nil {
+				return fmt.Errorf("failed to build desired regional hostnames: %w", err)
+			}
+			if len(desired) > 0 {
+				current, err := p.listDataLocalisationRegionalHostnames(ctx, zoneID)
+				if err != nil {
+					return fmt.Errorf("could not fetch regional hostnames from zone, %w", err)
+				}
+				if !p.submitRegionalHostnameChanges(ctx, zoneID, regionalHostnamesChanges(desired, current)) {
+					failedChange = true
+				}
+			}
+		}
+
+		if failedChange {
+			failedZones = append(failedZones, zoneID)
+		}
+	}
+
+	if len(failedZones) > 0 {
+		return provider.NewSoftErrorf("failed to submit all changes for the following zones: %q", failedZones)
+	}
+	return nil
+}
+
+// changesByZone separates changes by the id of the zone of their records
+func (p *Provider) changesByZone(zones []zones.Zone, changeSet []*cloudFlareChange) map[string][]*cloudFlareChange {
+	changes := make(map[string][]*cloudFlareChange)
+	zoneNameIDMapper := provider.ZoneIDName{}
+	for _, z := range zones {
+		zoneNameIDMapper.Add(z.ID, z.Name)
+		changes[z.ID] = []*cloudFlareChange{}
+	}
+
+	for _, c := range changeSet {
+		zoneID, _ := zoneNameIDMapper.FindZone(c.ResourceRecord.Name)
+		if zoneID == "" {
+			klog.V(4).Infof("skipping record %q because no hosted zone matches its DNS name", c.ResourceRecord.Name)
+			continue
+		}
+		changes[zoneID] = append(changes[zoneID], c)
+	}
+	return changes
+}
+
+// zoneHasPaidPlan returns true if the zone of hostname is on a paid plan, which allows longer comments
+func (p *Provider) zoneHasPaidPlan(ctx context.Context, hostname string) bool {
+	zone, err := publicsuffix.EffectiveTLDPlusOne(hostname)
+	if err != nil {
+		klog.Errorf("failed to get the effective TLD+1 of hostname %s: %v", hostname, err)
+		return false
+	}
+	zoneID, err := p.client.ZoneIDByName(ctx, zone)
+	if err != nil {
+		klog.Errorf("failed to get zone %s by name: %v", zone, err)
+		return false
+	}
+	zoneDetails, err := p.client.GetZone(ctx, zoneID)
+	if err != nil {
+		klog.Errorf("failed to get the details of zone %s: %v", zone, err)
+		return false
+	}
+	return zoneDetails.Plan.IsSubscribed //nolint:staticcheck // SA1019: Plan.IsSubscribed is deprecated but no replacement available yet
+}
+
+// trimComment trims comment to the maximum length of the plan of the zone of dnsName
+func (p *Provider) trimComment(ctx context.Context, dnsName, comment string) string {
+	if len(comment) <= freeZoneMaxCommentLength {
+		return comment
+	}
+
+	maxLength := freeZoneMaxCommentLength
+	if p.zoneHasPaidPlan(ctx, dnsName) {
+		maxLength = paidZoneMaxCommentLength
+	}
+	if len(comment) > maxLength {
+		klog.Warningf("trimming the comment of record %s, set it to less than %d chars to avoid endless syncs", dnsName, maxLength)
+		return comment[:maxLength]
+	}
+	return comment
+}
+
+func (p *Provider) newCustomHostname(customHostname string, origin string) cloudflarev0.CustomHostname {
+	return cloudflarev0.CustomHostname{
+		Hostname:           customHostname,
+		CustomOriginServer: origin,
+		SSL:                getCustomHostnamesSSLOptions(p.customHostnamesConfig),
+	}
+}
+
+// newCloudFlareChange returns the change of the record of target of ep. current is the endpoint updated by ep.
+func (p *Provider) newCloudFlareChange(ctx context.Context, action changeAction, ep *endpoint.Endpoint, target string, current *endpoint.Endpoint) (*cloudFlareChange, error) {
+	ttl := dns.TTL(defaultTTL)
+	if ep.RecordTTL.IsConfigured() {
+		ttl = dns.TTL(ep.RecordTTL)
+	}
+
+	prevCustomHostnames := []string{}
+	newCustomHostnames := map[string]cloudflarev0.CustomHostname{}
+	if p.customHostnamesConfig.Enabled {
+		if current != nil {
+			prevCustomHostnames = getEndpointCustomHostnames(current)
+		}
+		for _, v := range getEndpointCustomHostnames(ep) {
+			newCustomHostnames[v] = p.newCustomHostname(v, ep.DNSName)
+		}
+	}
+
+	// the comment of the endpoint overrides the comment of the config
+	comment := p.dnsRecordsConfig.Comment
+	if val, ok := ep.GetProviderSpecificProperty(annotations.CloudflareRecordCommentKey); ok {
+		comment = val
+	}
+	comment = p.trimComment(ctx, ep.DNSName, comment)
+
+	var tags []string
+	if val, ok := ep.GetProviderSpecificProperty(annotations.CloudflareTagsKey); ok {
+		tags = parseTagsAnnotation(val)
+	}
+
+	var priority float64
+	if ep.RecordType == endpoint.RecordTypeMX {
+		mxRecord, err := endpoint.NewMXRecord(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse MX record target %q: %w", target, err)
+		}
+		priority = float64(*mxRecord.GetPriority())
+		target = *mxRecord.GetHost()
+	}
+
+	return &cloudFlareChange{
+		Action: action,
+		ResourceRecord: dns.RecordResponse{
+			Name:     ep.DNSName,
+			TTL:      ttl,
+			Proxied:  shouldBeProxied(ep, p.proxiedByDefault),
+			Type:     dns.RecordResponseType(ep.RecordType),
+			Content:  target,
+			Comment:  comment,
+			Tags:     tags,
+			Priority: priority,
+		},
+		RegionalHostname:    p.regionalHostname(ep),
+		CustomHostnamesPrev: prevCustomHostnames,
+		CustomHostnames:     newCustomHostnames,
+	}, nil
+}
+
+func newDNSRecordIndex(r dns.RecordResponse) dnsRecordIndex {
+	return dnsRecordIndex{Name: r.Name, Type: string(r.Type), Content: r.Content}
+}
+
+func getRecordID(records dnsRecordsMap, record dns.RecordResponse) string {
+	if zoneRecord, ok := records[newDNSRecordIndex(record)]; ok {
+		return zoneRecord.ID
+	}
+	return ""
+}
+
+// getDNSRecordsMap returns the records of the zone, keyed by their name, type and content
+func (p *Provider) getDNSRecordsMap(ctx context.Context, zoneID string) (dnsRecordsMap, error) {
+	records := make(dnsRecordsMap)
+	params := dns.RecordListParams{ZoneID: cloudflare.F(zoneID)}
+	if p.dnsRecordsConfig.PerPage > 0 {
+		params.PerPage = cloudflare.F(float64(p.dnsRecordsConfig.PerPage))
+	}
+	iter := p.client.ListDNSRecords(ctx, params)
+	for record := range autoPagerIterator(iter) {
+		records[newDNSRecordIndex(record)] = record
+	}
+	if iter.Err() != nil {
+		return nil, convertCloudflareError(iter.Err())
+	}
+	return records, nil
+}
+
+func getCustomHostname(chs customHostnamesMap, name string) (cloudflarev0.CustomHostname, error) {
+	if name == "" {
+		return cloudflarev0.CustomHostname{}, errors.New("failed to get custom hostname: the name is empty")
+	}
+	if ch, ok := chs[name]; ok {
+		return ch, nil
+	}
+	return cloudflarev0.CustomHostname{}, fmt.Errorf("failed to get custom hostname: %q not found", name)
+}
+
+// listCustomHostnamesWithPagination returns the custom hostnames of the zone, nil if they are not enabled
+func (p *Provider) listCustomHostnamesWithPagination(ctx context.Context, zoneID string) (customHostnamesMap, error) {
+	if !p.customHostnamesConfig.Enabled {
+		return nil, nil
+	}
+
+	chs := make(customHostnamesMap)
+	resultInfo := cloudflarev0.ResultInfo{Page: 1}
+	for {
+		page, result, err := p.client.CustomHostnames(ctx, zoneID, resultInfo.Page, cloudflarev0.CustomHostname{})
+		if err != nil {
+			converted := convertCloudflareError(err)
+			if !errors.Is(converted, provider.SoftError) {
+				klog.Errorf("failed to fetch the custom hostnames of zone %q, check that \"Cloudflare for SaaS\" is enabled and the permissions of the API key: %v", zoneID, err)
+			}
+			return nil, converted
+		}
+		for _, ch := range page {
+			chs[ch.Hostname] = ch
+		}
+		resultInfo = result.Next()
+		if resultInfo.Done() {
+			break
+		}
+	}
+	return chs, nil
+}
+
+func getCustomHostnamesSSLOptions(config CustomHostnamesConfig) *cloudflarev0.CustomHostnameSSL {
+	ssl := &cloudflarev0.CustomHostnameSSL{
+		Type:         "dv",
+		Method:       "http",
+		BundleMethod: "ubiquitous",
+		Settings: cloudflarev0.CustomHostnameSSLSettings{
+			MinTLSVersion: config.MinTLSVersion,
+		},
+	}
+	// the certificate authority can only be set, even empty, on the enterprise plan
+	if config.CertificateAuthority != "none" {
+		ssl.CertificateAuthority = config.CertificateAuthority
+	}
+	return ssl
+}
+
+// parseTagsAnnotation returns the sorted tags of the comma separated list tagString
+func parseTagsAnnotation(tagString string) []string {
+	tags := strings.Split(tagString, ",")
+	cleaned := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			cleaned = append(cleaned, trimmed)
+		}
+	}
+	sort.Strings(cleaned)
+	return cleaned
+}
+
+// shouldBeProxied returns the proxied property of ep, proxiedByDefault when it is not set
+func shouldBeProxied(ep *endpoint.Endpoint, proxiedByDefault bool) bool {
+	if recordTypeProxyNotSupported[ep.RecordType] {
+		return false
+	}
+
+	proxied := proxiedByDefault
+	if v, ok := ep.GetProviderSpecificProperty(annotations.CloudflareProxiedKey); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			klog.Errorf("failed to parse annotation [%q]: %v", annotations.CloudflareProxiedKey, err)
+		} else {
+			proxied = b
+		}
+	}
+	return proxied
+}
+
+func getEndpointCustomHostnames(ep *endpoint.Endpoint) []string {
+	if v, ok := ep.GetProviderSpecificProperty(annotations.CloudflareCustomHostnameKey); ok {
+		return strings.Split(v, ",")
+	}
+	return []string{}
+}
+
+// groupByNameAndTypeWithCustomHostnames returns an endpoint for the records of every name and type, with the
+// custom hostnames whose origin is the name
+func (p *Provider) groupByNameAndTypeWithCustomHostnames(records dnsRecordsMap, chs customHostnamesMap) []*endpoint.Endpoint {
+	groups := map[string][]dns.RecordResponse{}
+	for _, r := range records {
+		if !p.SupportedAdditionalRecordTypes(string(r.Type)) {
+			continue
+		}
+		key := r.Name + string(r.Type)
+		groups[key] = append(groups[key], r)
+	}
+
+	customHostnames := map[string][]string{}
+	for _, c := range chs {
+		customHostnames[c.CustomOriginServer] = append(customHostnames[c.CustomOriginServer], c.Hostname)
+	}
+
+	var endpoints []*endpoint.Endpoint
+	for _, records := range groups {
+		targets := make([]string, len(records))
+		for i, record := range records {
+			if record.Type == dns.RecordResponseTypeMX {
+				targets[i] = fmt.Sprintf("%v %v", record.Priority, record.Content)
+			} else {
+				targets[i] = record.Content
+			}
+		}
+		e := endpoint.NewEndpointWithTTL(records[0].Name, string(records[0].Type), endpoint.TTL(records[0].TTL), targets...)
+		if e == nil {
+			continue
+		}
+		e = e.WithProviderSpecific(annotations.CloudflareProxiedKey, strconv.FormatBool(records[0].Proxied))
+		if names, ok := customHostnames[records[0].Name]; ok {
+			sort.Strings(names)
+			e = e.WithProviderSpecific(annotations.CloudflareCustomHostnameKey, strings.Join(names, ","))
+		}
+		if records[0].Comment != "" {
+			e = e.WithProviderSpecific(annotations.CloudflareRecordCommentKey, records[0].Comment)
+		}
+		if tags, ok := records[0].Tags.([]string); ok && len(tags) > 0 {
+			sort.Strings(tags)
+			e = e.WithProviderSpecific(annotations.CloudflareTagsKey, strings.Join(tags, ","))
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints
+}
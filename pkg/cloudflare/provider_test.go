@@ -0,0 +1,221 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	cloudflarev0 "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/cloudflare-go/v5/addressing"
+	"github.com/cloudflare/cloudflare-go/v5/custom_hostnames"
+	"github.com/cloudflare/cloudflare-go/v5/dns"
+	"github.com/cloudflare/cloudflare-go/v5/zones"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/source/annotations"
+)
+
+type fakePager[T any] struct {
+	items []T
+	i     int
+}
+
+func (p *fakePager[T]) Next() bool {
+	p.i++
+	return p.i <= len(p.items)
+}
+
+func (p *fakePager[T]) Current() T { return p.items[p.i-1] }
+
+func (p *fakePager[T]) Err() error { return nil }
+
+// fakeClient serves the zone example.com and records the calls changing it
+type fakeClient struct {
+	records []dns.RecordResponse
+	calls   []string
+}
+
+var _ client = &fakeClient{}
+
+func (c *fakeClient) ZoneIDByName(_ context.Context, zoneName string) (string, error) {
+	if zoneName == "example.com" {
+		return "zone-1", nil
+	}
+	return "", fmt.Errorf("zone %q not found", zoneName)
+}
+
+func (c *fakeClient) ListZones(context.Context, zones.ZoneListParams) autoPager[zones.Zone] {
+	return &fakePager[zones.Zone]{items: []zones.Zone{{ID: "zone-1", Name: "example.com"}}}
+}
+
+func (c *fakeClient) GetZone(_ context.Context, zoneID string) (*zones.Zone, error) {
+	return &zones.Zone{ID: zoneID, Name: "example.com"}, nil
+}
+
+func (c *fakeClient) ListDNSRecords(context.Context, dns.RecordListParams) autoPager[dns.RecordResponse] {
+	return &fakePager[dns.RecordResponse]{items: c.records}
+}
+
+func (c *fakeClient) CreateDNSRecord(_ context.Context, params dns.RecordNewParams) (*dns.RecordResponse, error) {
+	body := params.Body.(dns.RecordNewParamsBody)
+	c.calls = append(c.calls, "create "+body.Name.Value+" "+body.Content.Value)
+	return &dns.RecordResponse{}, nil
+}
+
+func (c *fakeClient) DeleteDNSRecord(_ context.Context, recordID string, _ dns.RecordDeleteParams) error {
+	c.calls = append(c.calls, "delete "+recordID)
+	return nil
+}
+
+func (c *fakeClient) UpdateDNSRecord(_ context.Context, recordID string, _ dns.RecordUpdateParams) (*dns.RecordResponse, error) {
+	c.calls = append(c.calls, "update "+recordID)
+	return &dns.RecordResponse{}, nil
+}
+
+func (c *fakeClient) ListDataLocalizationRegionalHostnames(context.Context, addressing.RegionalHostnameListParams) autoPager[addressing.RegionalHostnameListResponse] {
+	return &fakePager[addressing.RegionalHostnameListResponse]{}
+}
+
+func (c *fakeClient) CreateDataLocalizationRegionalHostname(_ context.Context, params addressing.RegionalHostnameNewParams) error {
+	c.calls = append(c.calls, "create regional "+params.Hostname.Value+" "+params.RegionKey.Value)
+	return nil
+}
+
+func (c *fakeClient) UpdateDataLocalizationRegionalHostname(_ context.Context, hostname string, _ addressing.RegionalHostnameEditParams) error {
+	c.calls = append(c.calls, "update regional "+hostname)
+	return nil
+}
+
+func (c *fakeClient) DeleteDataLocalizationRegionalHostname(_ context.Context, hostname string, _ addressing.RegionalHostnameDeleteParams) error {
+	c.calls = append(c.calls, "delete regional "+hostname)
+	return nil
+}
+
+func (c *fakeClient) CustomHostnames(context.Context, string, int, cloudflarev0.CustomHostname) ([]cloudflarev0.CustomHostname, cloudflarev0.ResultInfo, error) {
+	return nil, cloudflarev0.ResultInfo{}, nil
+}
+
+func (c *fakeClient) DeleteCustomHostname(_ context.Context, customHostnameID string, _ custom_hostnames.CustomHostnameDeleteParams) error {
+	c.calls = append(c.calls, "delete custom hostname "+customHostnameID)
+	return nil
+}
+
+func (c *fakeClient) CreateCustomHostname(_ context.Context, _ string, ch cloudflarev0.CustomHostname) (*cloudflarev0.CustomHostnameResponse, error) {
+	c.calls = append(c.calls, "create custom hostname "+ch.Hostname)
+	return &cloudflarev0.CustomHostnameResponse{}, nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{records: []dns.RecordResponse{
+		{ID: "1", Name: "www.example.com", Type: dns.RecordResponseTypeA, Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{ID: "2", Name: "www.example.com", Type: dns.RecordResponseTypeA, Content: "192.0.2.2", TTL: 1, Proxied: true},
+		{ID: "3", Name: "example.com", Type: dns.RecordResponseTypeMX, Content: "mail.example.com", Priority: 10, TTL: 300},
+	}}
+}
+
+func TestProviderRecords(t *testing.T) {
+	p := newProvider(newFakeClient(), Config{DomainFilter: endpoint.NewDomainFilter(nil)})
+
+	records, err := p.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].DNSName < records[j].DNSName })
+	// the A records of www share one endpoint
+	if len(records) != 2 || records[0].Targets[0] != "10 mail.example.com" || len(records[1].Targets) != 2 {
+		t.Fatalf("unexpected records: %v", records)
+	}
+	if proxied, _ := records[1].GetProviderSpecificProperty(annotations.CloudflareProxiedKey); proxied != "true" {
+		t.Fatalf("proxied = %q, want true", proxied)
+	}
+}
+
+func TestProviderApplyChanges(t *testing.T) {
+	c := newFakeClient()
+	p := newProvider(c, Config{
+		DomainFilter:           endpoint.NewDomainFilter(nil),
+		RegionalServicesConfig: RegionalServicesConfig{RegionKey: "eu"},
+	})
+
+	changes := &plan.Changes{
+		Create:    []*endpoint.Endpoint{endpoint.NewEndpoint("api.example.com", endpoint.RecordTypeCNAME, "www.example.com")},
+		UpdateOld: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1", "192.0.2.2")},
+		UpdateNew: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1", "192.0.2.3")},
+		Delete:    []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.org", endpoint.RecordTypeA, "192.0.2.1")},
+	}
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+
+	sort.Strings(c.calls)
+	want := []string{
+		"create api.example.com www.example.com",
+		"create regional api.example.com eu",
+		"create regional www.example.com eu",
+		"create www.example.com 192.0.2.3",
+		"delete 2",
+		"update 1",
+	}
+	if fmt.Sprint(c.calls) != fmt.Sprint(want) {
+		t.Fatalf("calls = %v, want %v", c.calls, want)
+	}
+
+	// a dry run does not change the records
+	c.calls = nil
+	p.dryRun = true
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+	if len(c.calls) != 0 {
+		t.Fatalf("dry run provider called %v", c.calls)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization")+r.Header.Get("X-Auth-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"success":true,"errors":[],"messages":[],"result":[],"result_info":{"page":1,"per_page":20,"count":0,"total_count":0}}`)
+	}))
+	defer server.Close()
+
+	for _, config := range []Config{
+		{APIToken: "token", BaseURL: server.URL},
+		{APIKey: "key", APIEmail: "edns@example.com", BaseURL: server.URL},
+	} {
+		p, err := NewProvider(config)
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		if _, err := p.Records(context.Background()); err != nil {
+			t.Fatalf("failed to list records: %v", err)
+		}
+	}
+	if fmt.Sprint(auth) != "[Bearer token key]" {
+		t.Fatalf("requests authenticated with %v", auth)
+	}
+
+	if _, err := NewProvider(Config{APIKey: "key"}); err == nil {
+		t.Fatal("expected an error without the api email")
+	}
+}
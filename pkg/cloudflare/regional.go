@@ -0,0 +1,237 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/cloudflare/cloudflare-go/v5"
+	"github.com/cloudflare/cloudflare-go/v5/addressing"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/source/annotations"
+)
+
+// RegionalServicesConfig configures the regional hostnames of the records, https://developers.cloudflare.com/data-localization/regional-services/.
+// They are enabled when the default RegionKey is set.
+type RegionalServicesConfig struct {
+	Enabled   bool
+	RegionKey string
+}
+
+var recordTypeRegionalHostnameSupported = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CNAME": true,
+}
+
+type regionalHostname struct {
+	hostname  string
+	regionKey string
+}
+
+// regionalHostnamesMap holds the regional hostnames of a zone, keyed by their hostname
+type regionalHostnamesMap map[string]regionalHostname
+
+type regionalHostnameChange struct {
+	action changeAction
+	regionalHostname
+}
+
+func (z zoneService) ListDataLocalizationRegionalHostnames(ctx context.Context, params addressing.RegionalHostnameListParams) autoPager[addressing.RegionalHostnameListResponse] {
+	return z.regionalHostnames.ListAutoPaging(ctx, params)
+}
+
+func (z zoneService) CreateDataLocalizationRegionalHostname(ctx context.Context, params addressing.RegionalHostnameNewParams) error {
+	_, err := z.regionalHostnames.New(ctx, params)
+	return err
+}
+
+func (z zoneService) UpdateDataLocalizationRegionalHostname(ctx context.Context, hostname string, params addressing.RegionalHostnameEditParams) error {
+	_, err := z.regionalHostnames.Edit(ctx, hostname, params)
+	return err
+}
+
+func (z zoneService) DeleteDataLocalizationRegionalHostname(ctx context.Context, hostname string, params addressing.RegionalHostnameDeleteParams) error {
+	_, err := z.regionalHostnames.Delete(ctx, hostname, params)
+	return err
+}
+
+// submitRegionalHostnameChanges applies the regional hostname changes, returns false if one of them fails
+func (p *Provider) submitRegionalHostnameChanges(ctx context.Context, zoneID string, changes []regionalHostnameChange) bool {
+	failedChange := false
+	for _, change := range changes {
+		if !p.submitRegionalHostnameChange(ctx, zoneID, change) {
+			failedChange = true
+		}
+	}
+	return !failedChange
+}
+
+func (p *Provider) submitRegionalHostnameChange(ctx context.Context, zoneID string, change regionalHostnameChange) bool {
+	logger := klog.Background().WithValues(
+		"hostname", change.hostname,
+		"regionKey", change.regionKey,
+		"action", change.action.String(),
+		"zone", zoneID,
+	)
+	if p.dryRun {
+		logger.V(4).Info("dry run, skipping regional hostname change")
+		return true
+	}
+
+	var err error
+	switch change.action {
+	case cloudFlareCreate:
+		logger.V(4).Info("creating regional hostname")
+		err = p.client.CreateDataLocalizationRegionalHostname(ctx, addressing.RegionalHostnameNewParams{
+			ZoneID:    cloudflare.F(zoneID),
+			Hostname:  cloudflare.F(change.hostname),
+			RegionKey: cloudflare.F(change.regionKey),
+		})
+	case cloudFlareUpdate:
+		logger.V(4).Info("updating regional hostname")
+		err = p.client.UpdateDataLocalizationRegionalHostname(ctx, change.hostname, addressing.RegionalHostnameEditParams{
+			ZoneID:    cloudflare.F(zoneID),
+			RegionKey: cloudflare.F(change.regionKey),
+		})
+	case cloudFlareDelete:
+		logger.V(4).Info("deleting regional hostname")
+		err = p.client.DeleteDataLocalizationRegionalHostname(ctx, change.hostname, addressing.RegionalHostnameDeleteParams{
+			ZoneID: cloudflare.F(zoneID),
+		})
+	}
+	if err != nil {
+		logger.Error(err, "failed to change regional hostname")
+		return false
+	}
+	return true
+}
+
+// listDataLocalisationRegionalHostnames returns the regional hostnames of the zone
+func (p *Provider) listDataLocalisationRegionalHostnames(ctx context.Context, zoneID string) (regionalHostnamesMap, error) {
+	iter := p.client.ListDataLocalizationRegionalHostnames(ctx, addressing.RegionalHostnameListParams{ZoneID: cloudflare.F(zoneID)})
+	rhs := make(regionalHostnamesMap)
+	for rh := range autoPagerIterator(iter) {
+		rhs[rh.Hostname] = regionalHostname{hostname: rh.Hostname, regionKey: rh.RegionKey}
+	}
+	if iter.Err() != nil {
+		return nil, convertCloudflareError(iter.Err())
+	}
+	return rhs, nil
+}
+
+// regionalHostname returns the regional hostname of ep, with the region key of ep or else the default one. It is
+// empty if the regional services are disabled or the record type has no regional hostnames.
+func (p *Provider) regionalHostname(ep *endpoint.Endpoint) regionalHostname {
+	if !p.regionalServicesConfig.Enabled || !recordTypeRegionalHostnameSupported[ep.RecordType] {
+		return regionalHostname{}
+	}
+	regionKey := p.regionalServicesConfig.RegionKey
+	if epRegionKey, exists := ep.GetProviderSpecificProperty(annotations.CloudflareRegionKey); exists {
+		regionKey = epRegionKey
+	}
+	return regionalHostname{hostname: ep.DNSName, regionKey: regionKey}
+}
+
+// addEndpointsProviderSpecificRegionKeyProperty sets the region key of the endpoints to the one of their regional
+// hostname, empty if they have none
+func (p *Provider) addEndpointsProviderSpecificRegionKeyProperty(ctx context.Context, zoneID string, endpoints []*endpoint.Endpoint) error {
+	if !p.regionalServicesConfig.Enabled {
+		return nil
+	}
+
+	// skip the lookup of the regional hostnames if no endpoint can have one
+	var supported []*endpoint.Endpoint
+	for _, ep := range endpoints {
+		if recordTypeRegionalHostnameSupported[ep.RecordType] {
+			supported = append(supported, ep)
+		}
+	}
+	if len(supported) == 0 {
+		return nil
+	}
+
+	rhs, err := p.listDataLocalisationRegionalHostnames(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	for _, ep := range supported {
+		var regionKey string
+		if rh, found := rhs[ep.DNSName]; found {
+			regionKey = rh.regionKey
+		}
+		ep.SetProviderSpecificProperty(annotations.CloudflareRegionKey, regionKey)
+	}
+	return nil
+}
+
+// adjustEndpointProviderSpecificRegionKeyProperty removes the region key of ep if it can have no regional
+// hostname, otherwise it defaults it to the region key of the config
+func (p *Provider) adjustEndpointProviderSpecificRegionKeyProperty(ep *endpoint.Endpoint) {
+	if !p.regionalServicesConfig.Enabled || !recordTypeRegionalHostnameSupported[ep.RecordType] {
+		ep.DeleteProviderSpecificProperty(annotations.CloudflareRegionKey)
+		return
+	}
+	if _, ok := ep.GetProviderSpecificProperty(annotations.CloudflareRegionKey); !ok {
+		ep.SetProviderSpecificProperty(annotations.CloudflareRegionKey, p.regionalServicesConfig.RegionKey)
+	}
+}
+
+// desiredRegionalHostnames returns the regional hostnames of changes. A create or update of a hostname wins over
+// its delete, an empty region key means the hostname must have no regional hostname.
+func desiredRegionalHostnames(changes []*cloudFlareChange) ([]regionalHostname, error) {
+	rhs := make(map[string]regionalHostname)
+	for _, change := range changes {
+		hostname := change.RegionalHostname.hostname
+		if hostname == "" {
+			continue
+		}
+		rh, found := rhs[hostname]
+		switch {
+		case !found && change.Action == cloudFlareDelete:
+			rhs[hostname] = regionalHostname{hostname: hostname}
+		case !found, rh.regionKey == "" && change.Action != cloudFlareDelete:
+			rhs[hostname] = change.RegionalHostname
+		case change.Action == cloudFlareDelete:
+		case rh.regionKey != change.RegionalHostname.regionKey:
+			return nil, fmt.Errorf("conflicting region keys for regional hostname %q: %q and %q", hostname, rh.regionKey, change.RegionalHostname.regionKey)
+		}
+	}
+	return slices.Collect(maps.Values(rhs)), nil
+}
+
+// regionalHostnamesChanges returns the changes turning the current regional hostnames into the desired ones
+func regionalHostnamesChanges(desired []regionalHostname, current regionalHostnamesMap) []regionalHostnameChange {
+	changes := make([]regionalHostnameChange, 0)
+	for _, rh := range desired {
+		cur, found := current[rh.hostname]
+		switch {
+		case rh.regionKey == "" && found:
+			changes = append(changes, regionalHostnameChange{action: cloudFlareDelete, regionalHostname: rh})
+		case rh.regionKey == "":
+		case !found:
+			changes = append(changes, regionalHostnameChange{action: cloudFlareCreate, regionalHostname: rh})
+		case rh.regionKey != cur.regionKey:
+			changes = append(changes, regionalHostnameChange{action: cloudFlareUpdate, regionalHostname: rh})
+		}
+	}
+	return changes
+}
@@ -21,6 +21,7 @@ import (
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 	controllers "kubeops.dev/external-dns-operator/pkg/controllers/external-dns"
+	"kubeops.dev/external-dns-operator/pkg/plan"
 
 	"github.com/spf13/cobra"
 	v "gomodules.xyz/x/version"
@@ -35,6 +36,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 var (
@@ -45,6 +48,8 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(api.AddToScheme(scheme))
+	utilruntime.Must(gwapiv1.Install(scheme))
+	utilruntime.Must(gwapiv1alpha2.Install(scheme))
 }
 
 func NewCmdRun() *cobra.Command {
@@ -59,9 +64,10 @@ func NewCmdRun() *cobra.Command {
 		QPS   float32 = 1e6
 		Burst int     = 1e6
 
-		metricsAddr          string
-		enableLeaderElection bool
-		probeAddr            string
+		metricsAddr             string
+		enableLeaderElection    bool
+		probeAddr               string
+		maxConcurrentReconciles = 1
 	)
 	cmd := &cobra.Command{
 		Use:               "run",
@@ -72,6 +78,11 @@ func NewCmdRun() *cobra.Command {
 
 			ctrl.SetLogger(klogr.New()) // nolint:staticcheck
 
+			if err := plan.Init(); err != nil {
+				setupLog.Error(err, "unable to configure external-dns")
+				os.Exit(1)
+			}
+
 			cfg := ctrl.GetConfigOrDie()
 			cfg.QPS = QPS
 			cfg.Burst = Burst
@@ -100,8 +111,10 @@ func NewCmdRun() *cobra.Command {
 			}
 
 			if err = (&controllers.ExternalDNSReconciler{
-				Client: mgr.GetClient(),
-				Scheme: mgr.GetScheme(),
+				Client:                  mgr.GetClient(),
+				Scheme:                  mgr.GetScheme(),
+				Recorder:                mgr.GetEventRecorderFor("external-dns-operator"),
+				MaxConcurrentReconciles: maxConcurrentReconciles,
 			}).SetupWithManager(mgr); err != nil {
 				setupLog.Error(err, "unable to create controller", "controller", "ExternalDNS")
 				os.Exit(1)
@@ -134,6 +147,7 @@ func NewCmdRun() *cobra.Command {
 	cmd.Flags().BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	cmd.Flags().IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", maxConcurrentReconciles, "The maximum number of ExternalDNS objects reconciled concurrently")
 
 	return cmd
 }
@@ -18,7 +18,9 @@ package externaldns
 
 import (
 	"context"
-	"sync"
+	"fmt"
+	"slices"
+	"time"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 	"kubeops.dev/external-dns-operator/pkg/credentials"
@@ -26,29 +28,44 @@ import (
 	"kubeops.dev/external-dns-operator/pkg/plan"
 
 	"github.com/pkg/errors"
+	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	kmc "kmodules.xyz/client-go/client"
 	condutil "kmodules.xyz/client-go/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-var mutex sync.Mutex
+const (
+	finalizer = "externaldns.kubeops.dev/finalizer"
 
-const finalizer = "externaldns.kubeops.dev/finalizer"
+	// crdRecheckInterval is how often an ExternalDNS whose source CRD is missing is re-checked
+	crdRecheckInterval = time.Minute
+)
 
 // ExternalDNSReconciler reconciles a ExternalDNS object
 type ExternalDNSReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 
+	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles is the number of ExternalDNS objects reconciled in parallel
+	MaxConcurrentReconciles int
+
 	watcher *informers.ObjectTracker
 }
 
@@ -57,6 +74,27 @@ func newCondition(reason string, message string, generation int64, conditionStat
 	return &newCondition
 }
 
+// newReasonCondition returns a condition of type conditionType with a reason that differs from its type
+func newReasonCondition(conditionType, reason, message string, generation int64, conditionStatus bool) *kmapi.Condition {
+	cond := newCondition(conditionType, message, generation, conditionStatus)
+	cond.Reason = reason
+	return cond
+}
+
+// piholeOwnershipMessage explains the consequences of the noop registry used with the pihole provider
+const piholeOwnershipMessage = "Pi-hole cannot store ownership records, so the noop registry is used: every record in the domain filter is treated as " +
+	"managed by this ExternalDNS. With the sync policy, records created outside of it are deleted, and the Delete deletion policy deletes " +
+	"all of them. Use the upsert-only policy and the Retain deletion policy to leave other records alone."
+
+// finalizerChanged passes the updates of the finalizers and the deletion timestamp, which change neither the
+// generation nor the annotations. Adding the finalizer to a new ExternalDNS must reconcile it again.
+var finalizerChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !slices.Equal(e.ObjectOld.GetFinalizers(), e.ObjectNew.GetFinalizers()) ||
+			!e.ObjectOld.GetDeletionTimestamp().Equal(e.ObjectNew.GetDeletionTimestamp())
+	},
+}
+
 func newPhase(phase api.ExternalDNSPhase) *api.ExternalDNSPhase {
 	return &phase
 }
@@ -78,18 +116,38 @@ func (r *ExternalDNSReconciler) updateEdnsStatus(ctx context.Context, edns *api.
 	return patchErr
 }
 
-func (r *ExternalDNSReconciler) patchDNSRecords(ctx context.Context, edns *api.ExternalDNS, dnsRecs []api.DNSRecord) error {
+// patchDNSRecords records the result of a sync, along with the time of this and the next periodic sync
+func (r *ExternalDNSReconciler) patchDNSRecords(ctx context.Context, edns *api.ExternalDNS, result *plan.SyncResult, interval time.Duration) error {
 	generation := edns.Generation
+	now := metav1.Now()
 	_, patchErr := kmc.PatchStatus(ctx, r.Client, edns, func(obj client.Object) client.Object {
 		in := obj.(*api.ExternalDNS)
 		in.Status.ObservedGeneration = generation
-		in.Status.DNSRecords = dnsRecs
+		in.Status.DNSRecords = result.Records
+		in.Status.PendingChanges = result.PendingChanges
+		in.Status.PlanHash = result.PlanHash
+		in.Status.InMemoryRecords = result.InMemoryRecords
+		in.Status.LastSyncTime = &now
+		in.Status.NextSyncTime = nil
+		if interval > 0 {
+			in.Status.NextSyncTime = &metav1.Time{Time: now.Add(interval)}
+		}
 		return in
 	})
 
 	return patchErr
 }
 
+// clearApproval removes the approve-plan annotation of an applied plan, a later plan with the same changes needs a new approval
+func (r *ExternalDNSReconciler) clearApproval(ctx context.Context, edns *api.ExternalDNS) error {
+	_, err := kmc.Patch(ctx, r.Client, edns, func(obj client.Object) client.Object {
+		in := obj.(*api.ExternalDNS)
+		delete(in.Annotations, api.ApprovePlanAnnotation)
+		return in
+	})
+	return err
+}
+
 func (r *ExternalDNSReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	_ = log.FromContext(ctx)
 
@@ -135,7 +193,11 @@ func (r *ExternalDNSReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			newCondition(api.CreateAndRegisterWatcher, err.Error(), edns.Generation, false),
 			newPhase(api.ExternalDNSPhaseFailed),
 		); patchErr != nil {
-			err = errors.Wrap(err, patchErr.Error())
+			return ctrl.Result{}, errors.Wrap(err, patchErr.Error())
+		}
+		// the CRD of the source may be installed later, so check back instead of retrying hot
+		if informers.IsCRDNotInstalled(err) {
+			return ctrl.Result{RequeueAfter: crdRecheckInterval}, nil
 		}
 		return ctrl.Result{}, err
 	}
@@ -149,12 +211,9 @@ func (r *ExternalDNSReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, patchErr
 	}
 
-	mutex.Lock()
-	defer mutex.Unlock()
-
 	// SECRET AND CREDENTIALS
-	// create and set provider secret credentials and environment variables
-	err := credentials.SetCredential(ctx, r.Client, edns)
+	// read the provider secret, the credential is passed to the provider explicitly
+	cred, err := credentials.GetCredential(ctx, r.Client, edns)
 	if err != nil {
 		if patchErr := r.updateEdnsStatus(
 			ctx,
@@ -176,10 +235,56 @@ func (r *ExternalDNSReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, patchErr
 	}
 
+	// OWNERSHIP TRACKING
+	// Pi-hole cannot store ownership records, so the noop registry is used and every record is treated as owned
+	if edns.Spec.Provider == api.ProviderPihole {
+		if patchErr := r.updateEdnsStatus(
+			ctx,
+			edns,
+			newReasonCondition(api.OwnershipTracking, api.NoOwnershipRecords, piholeOwnershipMessage, edns.Generation, false),
+			nil,
+		); patchErr != nil {
+			return ctrl.Result{}, patchErr
+		}
+	}
+
+	// WEBHOOK SERVER
+	// run the webhook provider of the ExternalDNS, its Deployment reconciles the ExternalDNS again once available
+	if edns.Spec.Provider == api.ProviderWebhook && edns.Spec.Webhook != nil && edns.Spec.Webhook.Server != nil {
+		available, err := r.reconcileWebhookServer(ctx, edns)
+		if err != nil {
+			if patchErr := r.updateEdnsStatus(
+				ctx,
+				edns,
+				newCondition(api.ReconcileWebhookServer, err.Error(), edns.Generation, false),
+				newPhase(api.ExternalDNSPhaseFailed),
+			); patchErr != nil {
+				err = errors.Wrap(err, patchErr.Error())
+			}
+			return ctrl.Result{}, err
+		}
+		if !available {
+			return ctrl.Result{}, r.updateEdnsStatus(
+				ctx,
+				edns,
+				newCondition(api.ReconcileWebhookServer, "waiting for the webhook server to be available", edns.Generation, false),
+				newPhase(api.ExternalDNSPhaseInProgress),
+			)
+		}
+		if patchErr := r.updateEdnsStatus(
+			ctx,
+			edns,
+			newCondition(api.ReconcileWebhookServer, "Webhook server is available", edns.Generation, true),
+			nil,
+		); patchErr != nil {
+			return ctrl.Result{}, patchErr
+		}
+	}
+
 	// APPLY DNS RECORD
 	// SetDNSRecords creates the dns record according to user information
 	// successMsg is used to identify whether the 'plan applied' or 'already up to date'
-	dnsRecs, err := plan.SetDNSRecords(ctx, edns)
+	result, err := plan.SetDNSRecords(ctx, edns, cred)
 	if err != nil {
 		if patchErr := r.updateEdnsStatus(
 			ctx,
@@ -192,20 +297,55 @@ func (r *ExternalDNSReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	err = r.patchDNSRecords(ctx, edns, dnsRecs)
+	if result.ApprovalApplied {
+		if err := r.clearApproval(ctx, edns); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// resync periodically, so records changed outside of the operator are corrected
+	interval := plan.SyncInterval(edns)
+	err = r.patchDNSRecords(ctx, edns, result, interval)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	res := ctrl.Result{RequeueAfter: interval}
 
-	if len(dnsRecs) == 0 {
-		return ctrl.Result{}, r.updateEdnsStatus(
+	if result.DeleteLimitExceeded != nil {
+		msg := fmt.Sprintf("%s, annotate with %s=%s to apply it", result.DeleteLimitExceeded.Error(), api.ApprovePlanAnnotation, result.PlanHash)
+		r.Recorder.Event(edns, core.EventTypeWarning, api.MaxDeletesExceeded, msg)
+		return res, r.updateEdnsStatus(
+			ctx,
+			edns,
+			newReasonCondition(api.CreateAndApplyPlan, api.MaxDeletesExceeded, msg, edns.Generation, false),
+			newPhase(api.ExternalDNSPhaseFailed),
+		)
+	}
+	if result.WaitingForApproval {
+		return res, r.updateEdnsStatus(
+			ctx,
+			edns,
+			newCondition(api.CreateAndApplyPlan, fmt.Sprintf("plan %s is waiting for approval, annotate with %s=%s to apply it", result.PlanHash, api.ApprovePlanAnnotation, result.PlanHash), edns.Generation, false),
+			newPhase(api.ExternalDNSPhaseWaitingForApproval),
+		)
+	}
+	if result.PendingChanges != nil {
+		return res, r.updateEdnsStatus(
+			ctx,
+			edns,
+			newCondition(api.CreateAndApplyPlan, "dry run: plan computed, changes are pending in status", edns.Generation, true),
+			newPhase(api.ExternalDNSPhaseInProgress),
+		)
+	}
+	if len(result.Records) == 0 {
+		return res, r.updateEdnsStatus(
 			ctx,
 			edns,
 			newCondition(api.CreateAndApplyPlan, "no endpoints found for source", edns.Generation, true),
 			newPhase(api.ExternalDNSPhaseInProgress),
 		)
 	}
-	return ctrl.Result{}, r.updateEdnsStatus(
+	return res, r.updateEdnsStatus(
 		ctx,
 		edns,
 		newCondition(api.CreateAndApplyPlan, "plan applied", edns.Generation, true),
@@ -218,19 +358,45 @@ func (r *ExternalDNSReconciler) handleDeletion(ctx context.Context, edns *api.Ex
 		return ctrl.Result{}, nil
 	}
 
-	mutex.Lock()
-	defer mutex.Unlock()
-
-	if edns.Spec.Policy == nil || *edns.Spec.Policy == api.PolicySync {
-		if err := credentials.SetCredential(ctx, r.Client, edns); err != nil {
-			klog.Errorf("failed to set credentials for cleanup of %s/%s: %v", edns.Namespace, edns.Name, err)
+	switch edns.Spec.GetDeletionPolicy() {
+	case api.DeletionPolicyDelete:
+		cred, err := credentials.GetCredential(ctx, r.Client, edns)
+		if err != nil {
+			klog.Errorf("failed to get credentials for cleanup of %s/%s: %v", edns.Namespace, edns.Name, err)
 			return ctrl.Result{}, err
 		}
 
-		if err := plan.DeleteDNSRecords(ctx, edns); err != nil {
+		if err := plan.DeleteDNSRecords(ctx, edns, cred); plan.IsDryRunDeletion(err) {
+			return ctrl.Result{}, r.keepDryRunDeletion(ctx, edns, err)
+		} else if plan.IsDeleteLimitExceeded(err) {
+			// keep the finalizer, a spec or annotation change reconciles the object again
+			msg := fmt.Sprintf("%s, annotate with %s=true to delete them", err.Error(), api.OverrideDeleteSafetyAnnotation)
+			r.Recorder.Event(edns, core.EventTypeWarning, api.MaxDeletesExceeded, msg)
+			return ctrl.Result{}, r.updateEdnsStatus(
+				ctx,
+				edns,
+				newReasonCondition(api.DeleteDNSRecords, api.MaxDeletesExceeded, msg, edns.Generation, false),
+				newPhase(api.ExternalDNSPhaseFailed),
+			)
+		} else if err != nil {
 			klog.Errorf("failed to delete DNS records for %s/%s: %v", edns.Namespace, edns.Name, err)
 			return ctrl.Result{}, err
 		}
+	case api.DeletionPolicyOrphan:
+		cred, err := credentials.GetCredential(ctx, r.Client, edns)
+		if err != nil {
+			klog.Errorf("failed to get credentials for cleanup of %s/%s: %v", edns.Namespace, edns.Name, err)
+			return ctrl.Result{}, err
+		}
+
+		if err := plan.OrphanDNSRecords(ctx, edns, cred); plan.IsDryRunDeletion(err) {
+			return ctrl.Result{}, r.keepDryRunDeletion(ctx, edns, err)
+		} else if err != nil {
+			klog.Errorf("failed to delete ownership records for %s/%s: %v", edns.Namespace, edns.Name, err)
+			return ctrl.Result{}, err
+		}
+	case api.DeletionPolicyRetain:
+		klog.Infof("cleanup: DNS records of %s/%s are retained", edns.Namespace, edns.Name)
 	}
 
 	// Remove the on-disk credential file before dropping the finalizer so
@@ -240,10 +406,37 @@ func (r *ExternalDNSReconciler) handleDeletion(ctx context.Context, edns *api.Ex
 		return ctrl.Result{}, err
 	}
 
+	// stop the informers of the cached source, the records of the inmemory provider are kept in memory
+	// until the ExternalDNS is deleted
+	plan.ForgetComponents(edns)
+	plan.ForgetInMemoryRecords(edns)
+
 	controllerutil.RemoveFinalizer(edns, finalizer)
 	return ctrl.Result{}, r.Update(ctx, edns)
 }
 
+// keepDryRunDeletion keeps the finalizer of an ExternalDNS deleted in dry run mode, and publishes the records
+// that are not deleted in status. Disabling the dry run or retaining the records reconciles it again.
+func (r *ExternalDNSReconciler) keepDryRunDeletion(ctx context.Context, edns *api.ExternalDNS, err error) error {
+	var dryRun *plan.DryRunDeletionError
+	if !errors.As(err, &dryRun) {
+		return err
+	}
+
+	msg := fmt.Sprintf("%s, set spec.dryRun to false to delete them or spec.deletionPolicy to %s to keep them", err.Error(), api.DeletionPolicyRetain)
+	r.Recorder.Event(edns, core.EventTypeWarning, api.DryRunDeletion, msg)
+
+	generation := edns.Generation
+	_, patchErr := kmc.PatchStatus(ctx, r.Client, edns, func(obj client.Object) client.Object {
+		in := obj.(*api.ExternalDNS)
+		in.Status.ObservedGeneration = generation
+		in.Status.PendingChanges = dryRun.PendingChanges
+		in.Status.Conditions = condutil.SetCondition(in.Status.Conditions, *newReasonCondition(api.DeleteDNSRecords, api.DryRunDeletion, msg, generation, false))
+		return in
+	})
+	return patchErr
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ExternalDNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	secretToEdns := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
@@ -256,7 +449,7 @@ func (r *ExternalDNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 		for _, edns := range ednsList.Items {
 			switch edns.Spec.Provider {
-			case api.ProviderAWS:
+			case api.ProviderAWS, api.ProviderAWSSD:
 				if edns.Spec.AWS != nil && edns.Spec.AWS.SecretRef != nil && edns.Spec.AWS.SecretRef.Name == object.GetName() {
 					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
 				}
@@ -275,6 +468,106 @@ func (r *ExternalDNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				if edns.Spec.Cloudflare != nil && edns.Spec.Cloudflare.SecretRef != nil && edns.Spec.Cloudflare.SecretRef.Name == object.GetName() {
 					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
 				}
+
+			case api.ProviderRFC2136:
+				if edns.Spec.RFC2136 != nil && edns.Spec.RFC2136.SecretRef != nil && edns.Spec.RFC2136.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderPDNS:
+				if edns.Spec.PDNS != nil && edns.Spec.PDNS.SecretRef != nil && edns.Spec.PDNS.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderCoreDNS:
+				if edns.Spec.CoreDNS != nil && edns.Spec.CoreDNS.SecretRef != nil && edns.Spec.CoreDNS.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderWebhook:
+				if edns.Spec.Webhook != nil && edns.Spec.Webhook.SecretRef != nil && edns.Spec.Webhook.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderDigitalOcean:
+				if edns.Spec.DigitalOcean != nil && edns.Spec.DigitalOcean.SecretRef != nil && edns.Spec.DigitalOcean.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderLinode:
+				if edns.Spec.Linode != nil && edns.Spec.Linode.SecretRef != nil && edns.Spec.Linode.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderScaleway:
+				if edns.Spec.Scaleway != nil && edns.Spec.Scaleway.SecretRef != nil && edns.Spec.Scaleway.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderCivo:
+				if edns.Spec.Civo != nil && edns.Spec.Civo.SecretRef != nil && edns.Spec.Civo.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderDNSimple:
+				if edns.Spec.DNSimple != nil && edns.Spec.DNSimple.SecretRef != nil && edns.Spec.DNSimple.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderGandi:
+				if edns.Spec.Gandi != nil && edns.Spec.Gandi.SecretRef != nil && edns.Spec.Gandi.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderGoDaddy:
+				if edns.Spec.GoDaddy != nil && edns.Spec.GoDaddy.SecretRef != nil && edns.Spec.GoDaddy.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderAkamai:
+				if edns.Spec.Akamai != nil && edns.Spec.Akamai.SecretRef != nil && edns.Spec.Akamai.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderOCI:
+				if edns.Spec.OCI != nil && edns.Spec.OCI.SecretRef != nil && edns.Spec.OCI.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderPihole:
+				if edns.Spec.Pihole != nil && edns.Spec.Pihole.SecretRef != nil && edns.Spec.Pihole.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderNS1:
+				if edns.Spec.NS1 != nil && edns.Spec.NS1.SecretRef != nil && edns.Spec.NS1.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderOVH:
+				if edns.Spec.OVH != nil && edns.Spec.OVH.SecretRef != nil && edns.Spec.OVH.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderTransIP:
+				if edns.Spec.TransIP != nil && edns.Spec.TransIP.SecretRef != nil && edns.Spec.TransIP.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderExoscale:
+				if edns.Spec.Exoscale != nil && edns.Spec.Exoscale.SecretRef != nil && edns.Spec.Exoscale.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+
+			case api.ProviderAlibabaCloud:
+				if edns.Spec.AlibabaCloud != nil && edns.Spec.AlibabaCloud.SecretRef != nil && edns.Spec.AlibabaCloud.SecretRef.Name == object.GetName() {
+					reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
+				}
+			}
+
+			// the AES keys of the TXT registry may be kept apart from the provider secret, for any provider
+			if encryption := edns.Spec.GetTXTEncryption(); encryption != nil && encryption.SecretRef.Name == object.GetName() {
+				reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
 			}
 		}
 
@@ -282,9 +575,13 @@ func (r *ExternalDNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	})
 
 	// for dynamic watcher
-	controller, err := ctrl.NewControllerManagedBy(mgr).
-		For(&api.ExternalDNS{}).
+	ednsController, err := ctrl.NewControllerManagedBy(mgr).
+		// status updates, like the sync times, must not trigger another sync
+		For(&api.ExternalDNS{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}, finalizerChanged))).
 		Watches(&core.Secret{}, secretToEdns).
+		Owns(&apps.Deployment{}).
+		Owns(&core.Service{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Build(r)
 	if err != nil {
 		klog.ErrorS(err, "failed to build controller")
@@ -293,7 +590,7 @@ func (r *ExternalDNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	r.watcher = &informers.ObjectTracker{
 		Manager:    mgr,
-		Controller: controller,
+		Controller: ednsController,
 	}
 
 	return nil
@@ -0,0 +1,72 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	condutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("ExternalDNS controller", func() {
+	const (
+		timeout  = 30 * time.Second
+		interval = 250 * time.Millisecond
+	)
+	ctx := context.Background()
+
+	It("syncs a new ExternalDNS after adding its finalizer", func() {
+		ns := &core.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "finalizer"}}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		edns := &api.ExternalDNS{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "finalizer"},
+			Spec: api.ExternalDNSSpec{
+				Source:       api.SourceConfig{Type: api.TypeInfo{Group: "", Version: "v1", Kind: "Service"}},
+				Provider:     api.ProviderInMemory,
+				DomainFilter: []string{"finalizer.example.com"},
+				InMemory:     &api.InMemoryProvider{Zones: []string{"finalizer.example.com"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, edns)).To(Succeed())
+
+		// the finalizer update changes neither the generation nor the annotations, the sync must follow it anyway
+		Eventually(func() bool {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(edns), edns); err != nil {
+				return false
+			}
+			return edns.Status.LastSyncTime != nil
+		}, timeout, interval).Should(BeTrue())
+		Expect(controllerutil.ContainsFinalizer(edns, finalizer)).To(BeTrue())
+		Expect(condutil.IsConditionTrue(edns.Status.Conditions, api.CreateAndApplyPlan)).To(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, edns)).To(Succeed())
+		Eventually(func() bool {
+			return kerr.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(edns), &api.ExternalDNS{}))
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -0,0 +1,90 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ExternalDNS with the inmemory provider", func() {
+	const (
+		timeout  = 30 * time.Second
+		interval = 250 * time.Millisecond
+	)
+	ctx := context.Background()
+
+	It("creates the records of a Service and deletes them with the ExternalDNS", func() {
+		ns := &core.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "inmemory"}}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		svc := &core.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ns.Name,
+				Name:        "web",
+				Annotations: map[string]string{"external-dns.alpha.kubernetes.io/hostname": "web.example.com"},
+			},
+			Spec: core.ServiceSpec{
+				Type:  core.ServiceTypeLoadBalancer,
+				Ports: []core.ServicePort{{Port: 80}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, svc)).To(Succeed())
+		svc.Status.LoadBalancer.Ingress = []core.LoadBalancerIngress{{IP: "192.0.2.10"}}
+		Expect(k8sClient.Status().Update(ctx, svc)).To(Succeed())
+
+		edns := &api.ExternalDNS{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "inmemory"},
+			Spec: api.ExternalDNSSpec{
+				Source:       api.SourceConfig{Type: api.TypeInfo{Group: "", Version: "v1", Kind: "Service"}},
+				Provider:     api.ProviderInMemory,
+				DomainFilter: []string{"example.com"},
+				InMemory:     &api.InMemoryProvider{Zones: []string{"example.com"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, edns)).To(Succeed())
+
+		By("syncing the record of the Service")
+		Eventually(func() []api.InMemoryRecord {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(edns), edns); err != nil {
+				return nil
+			}
+			return edns.Status.InMemoryRecords
+		}, timeout, interval).Should(ContainElement(api.InMemoryRecord{
+			Name:    "web.example.com",
+			Type:    "A",
+			Targets: []string{"192.0.2.10"},
+		}))
+		Expect(edns.Status.Phase).To(Equal(api.ExternalDNSPhaseCurrent))
+		Expect(edns.Status.DNSRecords).To(ConsistOf(api.DNSRecord{Name: "web.example.com", Target: "192.0.2.10"}))
+
+		By("deleting the ExternalDNS")
+		Expect(k8sClient.Delete(ctx, edns)).To(Succeed())
+		Eventually(func() bool {
+			return kerr.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(edns), &api.ExternalDNS{}))
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -17,6 +17,8 @@ limitations under the License.
 package externaldns
 
 import (
+	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -28,6 +30,8 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -41,6 +45,7 @@ var (
 	cfg       *rest.Config
 	k8sClient client.Client
 	testEnv   *envtest.Environment
+	cancelMgr context.CancelFunc
 )
 
 func TestAPIs(t *testing.T) {
@@ -54,7 +59,7 @@ var _ = BeforeSuite(func() {
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "crds")},
 		ErrorIfCRDPathMissing: true,
 	}
 
@@ -72,10 +77,40 @@ var _ = BeforeSuite(func() {
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
+
+	// the sources of the ExternalDNS objects read the test API server through KUBECONFIG
+	user, err := testEnv.AddUser(envtest.User{Name: "external-dns-operator", Groups: []string{"system:masters"}}, nil)
+	Expect(err).NotTo(HaveOccurred())
+	kubeconfig, err := user.KubeConfig()
+	Expect(err).NotTo(HaveOccurred())
+	dir, err := os.MkdirTemp("", "external-dns-operator-test")
+	Expect(err).NotTo(HaveOccurred())
+	kubeconfigPath := filepath.Join(dir, "kubeconfig")
+	Expect(os.WriteFile(kubeconfigPath, kubeconfig, 0o600)).To(Succeed())
+	Expect(os.Setenv(clientcmd.RecommendedConfigPathEnvVar, kubeconfigPath)).To(Succeed())
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme.Scheme})
+	Expect(err).NotTo(HaveOccurred())
+	err = (&ExternalDNSReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("external-dns-operator"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	var ctx context.Context
+	ctx, cancelMgr = context.WithCancel(context.Background())
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(ctx)).To(Succeed())
+	}()
 }, 60)
 
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
+	if cancelMgr != nil {
+		cancelMgr()
+	}
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
@@ -0,0 +1,96 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const webhookContainerName = "webhook"
+
+func webhookServerLabels(edns *api.ExternalDNS) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "external-dns-webhook",
+		"app.kubernetes.io/instance":   edns.Name,
+		"app.kubernetes.io/managed-by": "external-dns-operator",
+	}
+}
+
+// reconcileWebhookServer creates or updates the Deployment and the Service of the webhook server of the
+// ExternalDNS, and reports whether the webhook server is available. Both are owned by the ExternalDNS,
+// so they are garbage collected with it.
+func (r *ExternalDNSReconciler) reconcileWebhookServer(ctx context.Context, edns *api.ExternalDNS) (bool, error) {
+	server := edns.Spec.Webhook.Server
+	port := ptr.Deref(server.Port, 8888)
+	labels := webhookServerLabels(edns)
+	meta := metav1.ObjectMeta{Name: edns.WebhookServerName(), Namespace: edns.Namespace}
+
+	deploy := &apps.Deployment{ObjectMeta: meta}
+	if _, err := controllerutil.CreateOrPatch(ctx, r.Client, deploy, func() error {
+		deploy.Labels = labels
+		deploy.Spec.Replicas = ptr.To(int32(1))
+		deploy.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+		deploy.Spec.Template.Labels = labels
+
+		container := core.Container{
+			Name:      webhookContainerName,
+			Image:     server.Image,
+			Args:      server.Args,
+			Env:       server.Env,
+			EnvFrom:   server.EnvFrom,
+			Resources: server.Resources,
+			Ports: []core.ContainerPort{
+				{Name: "http", ContainerPort: port, Protocol: core.ProtocolTCP},
+			},
+		}
+		// keep the defaults set by the API server on the existing container
+		if len(deploy.Spec.Template.Spec.Containers) == 1 && deploy.Spec.Template.Spec.Containers[0].Name == webhookContainerName {
+			existing := deploy.Spec.Template.Spec.Containers[0]
+			existing.Image, existing.Args, existing.Env, existing.EnvFrom = container.Image, container.Args, container.Env, container.EnvFrom
+			existing.Resources, existing.Ports = container.Resources, container.Ports
+			container = existing
+		}
+		deploy.Spec.Template.Spec.Containers = []core.Container{container}
+		return controllerutil.SetControllerReference(edns, deploy, r.Scheme)
+	}); err != nil {
+		return false, fmt.Errorf("failed to reconcile webhook deployment: %w", err)
+	}
+
+	svc := &core.Service{ObjectMeta: meta}
+	if _, err := controllerutil.CreateOrPatch(ctx, r.Client, svc, func() error {
+		svc.Labels = labels
+		svc.Spec.Selector = labels
+		svc.Spec.Ports = []core.ServicePort{
+			{Name: "http", Port: port, TargetPort: intstr.FromString("http"), Protocol: core.ProtocolTCP},
+		}
+		return controllerutil.SetControllerReference(edns, svc, r.Scheme)
+	}); err != nil {
+		return false, fmt.Errorf("failed to reconcile webhook service: %w", err)
+	}
+
+	return deploy.Status.ObservedGeneration >= deploy.Generation && deploy.Status.AvailableReplicas > 0, nil
+}
@@ -0,0 +1,437 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package coredns implements the CoreDNS provider, which stores the records in the etcd backend of CoreDNS. It
+// follows the upstream coredns provider, but takes the etcd client configuration from the caller instead of the
+// environment, so every ExternalDNS connects to its own etcd with its own credentials.
+package coredns
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	etcdcv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+const (
+	priority    = 10 // default priority when nothing is set
+	etcdTimeout = 5 * time.Second
+
+	randomPrefixLabel     = "prefix"
+	providerSpecificGroup = "coredns/group"
+
+	// DefaultEtcdURL is the etcd endpoint used when no URL is given
+	DefaultEtcdURL = "http://localhost:2379"
+)
+
+// client works with the CoreDNS service records in etcd
+type client interface {
+	GetServices(ctx context.Context, prefix string) ([]*Service, error)
+	SaveService(ctx context.Context, value *Service) error
+	DeleteService(ctx context.Context, key string) error
+}
+
+// Provider stores the records as CoreDNS services in etcd
+type Provider struct {
+	provider.BaseProvider
+	dryRun        bool
+	coreDNSPrefix string
+	domainFilter  *endpoint.DomainFilter
+	client        client
+}
+
+var _ provider.Provider = &Provider{}
+
+// Service represents CoreDNS etcd record
+type Service struct {
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Priority int    `json:"priority,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Mail     bool   `json:"mail,omitempty"` // Be an MX record. Priority becomes Preference.
+	TTL      uint32 `json:"ttl,omitempty"`
+
+	// When a SRV record with a "Host: IP-address" is added, we synthesize
+	// a srv.Target domain name.  Normally we convert the full Key where
+	// the record lives to a DNS name and use this as the srv.Target.  When
+	// TargetStrip > 0 we strip the left most TargetStrip labels from the
+	// DNS name.
+	TargetStrip int `json:"targetstrip,omitempty"`
+
+	// Group is used to group (or *not* to group) different services
+	// together. Services with an identical Group are returned in the same
+	// answer.
+	Group string `json:"group,omitempty"`
+
+	// Etcd key where we found this service and ignored from json un-/marshaling
+	Key string `json:"-"`
+}
+
+type etcdClient struct {
+	client *etcdcv3.Client
+}
+
+var _ client = etcdClient{}
+
+// GetServices returns all Service records stored in etcd anywhere under the given key (recursively)
+func (c etcdClient) GetServices(ctx context.Context, prefix string) ([]*Service, error) {
+	ctx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+
+	r, err := c.client.Get(ctx, prefix, etcdcv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	var svcs []*Service
+	bx := make(map[Service]bool)
+	for _, n := range r.Kvs {
+		svc := new(Service)
+		if err := json.Unmarshal(n.Value, svc); err != nil {
+			return nil, fmt.Errorf("%s: %w", n.Key, err)
+		}
+		b := Service{Host: svc.Host, Port: svc.Port, Priority: svc.Priority, Weight: svc.Weight, Text: svc.Text, Key: string(n.Key)}
+		if _, ok := bx[b]; ok {
+			// skip the service if already added to service list.
+			// the same service might be found in multiple etcd nodes.
+			continue
+		}
+		bx[b] = true
+
+		svc.Key = string(n.Key)
+		if svc.Priority == 0 {
+			svc.Priority = priority
+		}
+		svcs = append(svcs, svc)
+	}
+	return svcs, nil
+}
+
+// SaveService persists service data into etcd
+func (c etcdClient) SaveService(ctx context.Context, service *Service) error {
+	ctx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+
+	value, err := json.Marshal(&service)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.Put(ctx, service.Key, string(value))
+	return err
+}
+
+// DeleteService deletes service record from etcd
+func (c etcdClient) DeleteService(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+
+	_, err := c.client.Delete(ctx, key, etcdcv3.WithPrefix())
+	return err
+}
+
+// NewConfig returns the etcd client configuration for urls. The scheme of the first URL decides whether
+// tlsConfig is used, like the upstream provider does. With basic auth, the client authenticates while it is
+// created, so the dial timeout keeps an unreachable etcd from blocking the reconcile.
+func NewConfig(urls []string, username, password string, tlsConfig *tls.Config) (*etcdcv3.Config, error) {
+	if len(urls) == 0 {
+		urls = []string{DefaultEtcdURL}
+	}
+	config := &etcdcv3.Config{Endpoints: urls, Username: username, Password: password, DialTimeout: etcdTimeout}
+
+	firstURL := strings.ToLower(urls[0])
+	switch {
+	case strings.HasPrefix(firstURL, "http://"):
+		return config, nil
+	case strings.HasPrefix(firstURL, "https://"):
+		config.TLS = tlsConfig
+		return config, nil
+	default:
+		return nil, errors.New("etcd URLs must start with either http:// or https://")
+	}
+}
+
+// NewProvider returns a CoreDNS provider connecting to etcd with config
+func NewProvider(config etcdcv3.Config, domainFilter *endpoint.DomainFilter, prefix string, dryRun bool) (*Provider, error) {
+	c, err := etcdcv3.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return newProvider(etcdClient{c}, domainFilter, prefix, dryRun), nil
+}
+
+func newProvider(c client, domainFilter *endpoint.DomainFilter, prefix string, dryRun bool) *Provider {
+	return &Provider{
+		client:        c,
+		dryRun:        dryRun,
+		coreDNSPrefix: prefix,
+		domainFilter:  domainFilter,
+	}
+}
+
+// findEp takes an Endpoint slice and looks for an element in it. If found it will
+// return Endpoint, otherwise it will return nil and a bool of false.
+func findEp(slice []*endpoint.Endpoint, dnsName string) (*endpoint.Endpoint, bool) {
+	for _, item := range slice {
+		if item.DNSName == dnsName {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// findLabelInTargets takes an ep.Targets string slice and looks for an element in it. If found it will
+// return its string value, otherwise it will return empty string and a bool of false.
+func findLabelInTargets(targets []string, label string) (string, bool) {
+	for _, target := range targets {
+		if target == label {
+			return target, true
+		}
+	}
+	return "", false
+}
+
+// Records returns all DNS records found in CoreDNS etcd backend. Depending on the record fields
+// it may be mapped to one or two records of type A, CNAME, TXT, A+TXT, CNAME+TXT
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	var result []*endpoint.Endpoint
+	services, err := p.client.GetServices(ctx, p.coreDNSPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		domains := strings.Split(strings.TrimPrefix(service.Key, p.coreDNSPrefix), "/")
+		reverse(domains)
+		dnsName := strings.Join(domains[service.TargetStrip:], ".")
+		if !p.domainFilter.Match(dnsName) {
+			continue
+		}
+		prefix := strings.Join(domains[:service.TargetStrip], ".")
+		if service.Host != "" {
+			ep, found := findEp(result, dnsName)
+			if found {
+				ep.Targets = append(ep.Targets, service.Host)
+			} else {
+				ep = endpoint.NewEndpointWithTTL(
+					dnsName,
+					guessRecordType(service.Host),
+					endpoint.TTL(service.TTL),
+					service.Host,
+				)
+				if service.Group != "" {
+					ep.WithProviderSpecific(providerSpecificGroup, service.Group)
+				}
+			}
+			ep.Labels["originalText"] = service.Text
+			ep.Labels[randomPrefixLabel] = prefix
+			ep.Labels[service.Host] = prefix
+			result = append(result, ep)
+		}
+		if service.Text != "" {
+			ep := endpoint.NewEndpoint(
+				dnsName,
+				endpoint.RecordTypeTXT,
+				service.Text,
+			)
+			ep.Labels[randomPrefixLabel] = prefix
+			result = append(result, ep)
+		}
+	}
+	return result, nil
+}
+
+// ApplyChanges stores the created and updated records in etcd, and deletes the deleted ones
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	grouped := p.groupEndpoints(changes)
+
+	for dnsName, group := range grouped {
+		if !p.domainFilter.Match(dnsName) {
+			klog.V(4).Infof("skipping record %q due to domain filter", dnsName)
+			continue
+		}
+		if err := p.applyGroup(ctx, dnsName, group); err != nil {
+			return err
+		}
+	}
+
+	return p.deleteEndpoints(ctx, changes.Delete)
+}
+
+func (p *Provider) groupEndpoints(changes *plan.Changes) map[string][]*endpoint.Endpoint {
+	grouped := make(map[string][]*endpoint.Endpoint)
+	for _, ep := range changes.Create {
+		grouped[ep.DNSName] = append(grouped[ep.DNSName], ep)
+	}
+	for i, ep := range changes.UpdateNew {
+		ep.Labels = changes.UpdateOld[i].Labels
+		grouped[ep.DNSName] = append(grouped[ep.DNSName], ep)
+	}
+	return grouped
+}
+
+func (p *Provider) applyGroup(ctx context.Context, dnsName string, group []*endpoint.Endpoint) error {
+	var services []*Service
+
+	for _, ep := range group {
+		if ep.RecordType != endpoint.RecordTypeTXT {
+			srvs, err := p.createServicesForEndpoint(ctx, dnsName, ep)
+			if err != nil {
+				return err
+			}
+			services = append(services, srvs...)
+		}
+	}
+
+	services = p.updateTXTRecords(dnsName, group, services)
+
+	for _, service := range services {
+		klog.Infof("add/set key %s to Host=%s, Text=%s, TTL=%d", service.Key, service.Host, service.Text, service.TTL)
+		if p.dryRun {
+			continue
+		}
+		if err := p.client.SaveService(ctx, service); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) createServicesForEndpoint(ctx context.Context, dnsName string, ep *endpoint.Endpoint) ([]*Service, error) {
+	var services []*Service
+
+	for _, target := range ep.Targets {
+		prefix := ep.Labels[target]
+		if prefix == "" {
+			prefix = fmt.Sprintf("%08x", rand.Int31())
+		}
+		group := ""
+		if prop, ok := ep.GetProviderSpecificProperty(providerSpecificGroup); ok {
+			group = prop
+		}
+		service := Service{
+			Host:        target,
+			Text:        ep.Labels["originalText"],
+			Key:         p.etcdKeyFor(prefix + "." + dnsName),
+			TargetStrip: strings.Count(prefix, ".") + 1,
+			TTL:         uint32(ep.RecordTTL),
+			Group:       group,
+		}
+		services = append(services, &service)
+		ep.Labels[target] = prefix
+	}
+
+	// Clean outdated labels
+	for label, labelPrefix := range ep.Labels {
+		if shouldSkipLabel(label) {
+			continue
+		}
+		if _, ok := findLabelInTargets(ep.Targets, label); !ok {
+			key := p.etcdKeyFor(labelPrefix + "." + dnsName)
+			klog.Infof("delete key %s", key)
+			if p.dryRun {
+				continue
+			}
+			if err := p.client.DeleteService(ctx, key); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return services, nil
+}
+
+func shouldSkipLabel(label string) bool {
+	skip := []string{"originalText", "prefix", "resource"}
+	_, ok := findLabelInTargets(skip, label)
+	return ok
+}
+
+// updateTXTRecords updates the TXT records in the provided services slice based on the given group of endpoints.
+func (p *Provider) updateTXTRecords(dnsName string, group []*endpoint.Endpoint, services []*Service) []*Service {
+	index := 0
+	for _, ep := range group {
+		if ep.RecordType != endpoint.RecordTypeTXT {
+			continue
+		}
+		if index >= len(services) {
+			prefix := ep.Labels[randomPrefixLabel]
+			if prefix == "" {
+				prefix = fmt.Sprintf("%08x", rand.Int31())
+			}
+			services = append(services, &Service{
+				Key:         p.etcdKeyFor(prefix + "." + dnsName),
+				TargetStrip: strings.Count(prefix, ".") + 1,
+				TTL:         uint32(ep.RecordTTL),
+			})
+		}
+		services[index].Text = ep.Targets[0]
+		index++
+	}
+
+	for i := index; index > 0 && i < len(services); i++ {
+		services[i].Text = ""
+	}
+	return services
+}
+
+func (p *Provider) deleteEndpoints(ctx context.Context, endpoints []*endpoint.Endpoint) error {
+	for _, ep := range endpoints {
+		dnsName := ep.DNSName
+		if ep.Labels[randomPrefixLabel] != "" {
+			dnsName = ep.Labels[randomPrefixLabel] + "." + dnsName
+		}
+		key := p.etcdKeyFor(dnsName)
+		klog.Infof("delete key %s", key)
+		if p.dryRun {
+			continue
+		}
+		if err := p.client.DeleteService(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Provider) etcdKeyFor(dnsName string) string {
+	domains := strings.Split(dnsName, ".")
+	reverse(domains)
+	return p.coreDNSPrefix + strings.Join(domains, "/")
+}
+
+func guessRecordType(target string) string {
+	if net.ParseIP(target) != nil {
+		return endpoint.RecordTypeA
+	}
+	return endpoint.RecordTypeCNAME
+}
+
+func reverse(slice []string) {
+	for i := range len(slice) / 2 {
+		j := len(slice) - i - 1
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
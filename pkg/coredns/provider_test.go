@@ -0,0 +1,129 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coredns
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+// memoryClient keeps the services in memory, keyed like etcd
+type memoryClient map[string]*Service
+
+func (c memoryClient) GetServices(_ context.Context, prefix string) ([]*Service, error) {
+	var services []*Service
+	for key, svc := range c {
+		if strings.HasPrefix(key, prefix) {
+			s := *svc
+			s.Key = key
+			services = append(services, &s)
+		}
+	}
+	return services, nil
+}
+
+func (c memoryClient) SaveService(_ context.Context, service *Service) error {
+	c[service.Key] = service
+	return nil
+}
+
+func (c memoryClient) DeleteService(_ context.Context, key string) error {
+	for k := range c {
+		if strings.HasPrefix(k, key) {
+			delete(c, k)
+		}
+	}
+	return nil
+}
+
+func TestProvider(t *testing.T) {
+	c := memoryClient{}
+	p := newProvider(c, endpoint.NewDomainFilter([]string{"example.com"}), "/skydns/", false)
+	ctx := context.Background()
+
+	changes := &plan.Changes{
+		Create: []*endpoint.Endpoint{
+			endpoint.NewEndpointWithTTL("www.example.com", endpoint.RecordTypeA, 300, "192.0.2.1"),
+			endpoint.NewEndpoint("other.example.org", endpoint.RecordTypeA, "192.0.2.2"),
+		},
+	}
+	if err := p.ApplyChanges(ctx, changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+	if len(c) != 1 {
+		t.Fatalf("expected only the record matching the domain filter to be stored, got %v", c)
+	}
+	for key := range c {
+		if !strings.HasPrefix(key, "/skydns/com/example/www/") {
+			t.Fatalf("unexpected etcd key %s", key)
+		}
+	}
+
+	records, err := p.Records(ctx)
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) != 1 || records[0].DNSName != "www.example.com" || records[0].Targets[0] != "192.0.2.1" || records[0].RecordTTL != 300 {
+		t.Fatalf("unexpected records: %v", records)
+	}
+
+	if err := p.ApplyChanges(ctx, &plan.Changes{Delete: records}); err != nil {
+		t.Fatalf("failed to delete records: %v", err)
+	}
+	if len(c) != 0 {
+		t.Fatalf("records left after the deletion: %v", c)
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		urls    []string
+		wantTLS bool
+		wantErr bool
+	}{
+		{name: "default endpoint", urls: nil},
+		{name: "http", urls: []string{"http://etcd-0:2379", "https://etcd-1:2379"}},
+		{name: "https", urls: []string{"HTTPS://etcd-0:2379"}, wantTLS: true},
+		{name: "unsupported scheme", urls: []string{"etcd-0:2379"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := NewConfig(tc.urls, "user", "password", &tls.Config{})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewConfig error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(tc.urls) == 0 && (len(config.Endpoints) != 1 || config.Endpoints[0] != DefaultEtcdURL) {
+				t.Fatalf("unexpected default endpoints %v", config.Endpoints)
+			}
+			if (config.TLS != nil) != tc.wantTLS {
+				t.Fatalf("TLS configured = %v, want %v", config.TLS != nil, tc.wantTLS)
+			}
+			if config.Username != "user" || config.Password != "password" {
+				t.Fatalf("basic auth credentials are not set: %+v", config)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AkamaiCredential holds the EdgeGrid tokens of the akamai provider
+type AkamaiCredential struct {
+	ServiceConsumerDomain string
+	ClientToken           string
+	ClientSecret          string
+	AccessToken           string
+}
+
+func getAkamaiCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	// without credentials the provider would read the .edgerc file or the environment of the operator
+	if edns.Spec.Akamai == nil || edns.Spec.Akamai.SecretRef == nil {
+		return nil, errors.New("providerSecretRef is not given for akamai provider")
+	}
+
+	ref := edns.Spec.Akamai.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.ServiceConsumerDomainKey != "" && ref.ClientTokenKey != "" && ref.ClientSecretKey != "" && ref.AccessTokenKey != "" {
+		cred := &AkamaiCredential{}
+		if cred.ServiceConsumerDomain, err = secretValue(secret, ref.ServiceConsumerDomainKey); err != nil {
+			return nil, err
+		}
+		if cred.ClientToken, err = secretValue(secret, ref.ClientTokenKey); err != nil {
+			return nil, err
+		}
+		if cred.ClientSecret, err = secretValue(secret, ref.ClientSecretKey); err != nil {
+			return nil, err
+		}
+		if cred.AccessToken, err = secretValue(secret, ref.AccessTokenKey); err != nil {
+			return nil, err
+		}
+		// remove the .edgerc file of a previous secret reference
+		if err := os.Remove(credentialFilePath(edns)); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return &Credential{Akamai: cred}, nil
+	}
+
+	if ref.EdgercKey == "" {
+		return nil, errors.New("invalid akamai provider secret, either the four EdgeGrid token keys or the edgerc key must be set")
+	}
+	edgerc, err := secretValue(secret, ref.EdgercKey)
+	if err != nil {
+		return nil, err
+	}
+	filePath, err := writeCredentialFile(edns, []byte(edgerc))
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{FilePath: filePath}, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getAlibabaCloudCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	// without a provider secret the RAM role of the ECS instance is used
+	if edns.Spec.AlibabaCloud == nil || edns.Spec.AlibabaCloud.SecretRef == nil {
+		// remove the config file of a previous secret reference
+		if err := os.Remove(credentialFilePath(edns)); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return &Credential{}, nil
+	}
+
+	ref := edns.Spec.AlibabaCloud.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.ConfigKey == "" {
+		return nil, errors.New("configKey is required for alibabacloud provider")
+	}
+	config, err := secretValue(secret, ref.ConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	// the file is used as the alibabacloud config file of this instance
+	filePath, err := writeCredentialFile(edns, []byte(config))
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{FilePath: filePath}, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAlibabaCloudCredential(t *testing.T) {
+	const config = `{"regionId":"cn-hangzhou","accessKeyId":"id","accessKeySecret":"secret","vpcId":"vpc"}`
+	kc := newFakeSecretClient(newTestSecret("alibabacloud-credential", map[string]string{"alibaba-cloud.json": config}))
+
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "alibabacloud-test"},
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderAlibabaCloud,
+			AlibabaCloud: &api.AlibabaCloudProvider{
+				SecretRef: &api.AlibabaCloudSecretReference{Name: "alibabacloud-credential", ConfigKey: "alibaba-cloud.json"},
+			},
+		},
+	}
+	cred, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	t.Cleanup(func() { _ = CleanupCredential(edns) })
+
+	data, err := os.ReadFile(cred.FilePath)
+	if err != nil {
+		t.Fatalf("failed to read the config file: %v", err)
+	}
+	if string(data) != config {
+		t.Fatalf("config file = %q, want %q", data, config)
+	}
+
+	// without the secret reference the RAM role is used, and the config file of the secret is removed
+	edns.Spec.AlibabaCloud.SecretRef = nil
+	cred2, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	if cred2.FilePath != "" {
+		t.Fatalf("unexpected config file %q", cred2.FilePath)
+	}
+	if _, err := os.Stat(cred.FilePath); !os.IsNotExist(err) {
+		t.Fatalf("config file is not removed: %v", err)
+	}
+}
@@ -19,8 +19,6 @@ package credentials
 import (
 	"context"
 	"errors"
-	"fmt"
-	"os"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -29,50 +27,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const AWSSharedCredentialsFile = "AWS_SHARED_CREDENTIALS_FILE"
-
 func validAWSSecret(secret *core.Secret, key string) bool {
 	_, found := secret.Data[key]
 	return found
 }
 
-func setAWSCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) error {
-	// Env reset of all provider variables is done centrally in SetCredential.
+func getAWSCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
 	// if ProviderSecretRef is nil then user is intended to use IRSA (IAM Role for Service Account)
 	if edns.Spec.AWS == nil || edns.Spec.AWS.SecretRef == nil {
-		return nil
+		return &Credential{}, nil
 	}
 
 	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: edns.Spec.AWS.SecretRef.Name})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !validAWSSecret(secret, edns.Spec.AWS.SecretRef.CredentialKey) {
-		return errors.New("invalid aws provider secret")
-	}
-
-	fileName := fmt.Sprintf("%s-%s-credential", edns.Namespace, edns.Name)
-
-	filePath := fmt.Sprintf("/tmp/%s", fileName)
-	// Remove any pre-existing file so we don't inherit looser permissions
-	// from an earlier reconcile or an older operator version.
-	_ = os.Remove(filePath)
-	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
-	if err != nil {
-		return err
-	}
-	defer file.Close() // nolint:errcheck
-
-	b := secret.Data[edns.Spec.AWS.SecretRef.CredentialKey]
-	_, err = file.Write(b)
-	if err != nil {
-		return err
+		return nil, errors.New("invalid aws provider secret")
 	}
 
-	err = os.Setenv(AWSSharedCredentialsFile, filePath)
+	// the file is used as the shared credentials file of this instance only
+	filePath, err := writeCredentialFile(edns, secret.Data[edns.Spec.AWS.SecretRef.CredentialKey])
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return &Credential{FilePath: filePath}, nil
 }
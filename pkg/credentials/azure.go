@@ -19,8 +19,6 @@ package credentials
 import (
 	"context"
 	"errors"
-	"fmt"
-	"os"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -34,37 +32,25 @@ func validAzureSecret(secret *core.Secret, key string) bool {
 	return found
 }
 
-func setAzureCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) error {
+func getAzureCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
 	// for azure, user must have to provide ProviderSecretRef
 	if edns.Spec.Azure == nil || edns.Spec.Azure.SecretRef == nil {
-		return errors.New("providerSecretRef is not given for azure provider")
+		return nil, errors.New("providerSecretRef is not given for azure provider")
 	}
 
 	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: edns.Spec.Azure.SecretRef.Name})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !validAzureSecret(secret, edns.Spec.Azure.SecretRef.CredentialKey) {
-		return errors.New("invalid Azure provider secret")
+		return nil, errors.New("invalid Azure provider secret")
 	}
-	fileName := fmt.Sprintf("%s-%s-credential", edns.Namespace, edns.Name)
-	filepath := fmt.Sprintf("/tmp/%s", fileName)
 
-	// Remove any pre-existing file so we don't inherit looser permissions
-	// from an earlier reconcile or an older operator version.
-	_ = os.Remove(filepath)
-	file, err := os.OpenFile(filepath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	// the file is used as the azure config file of this instance
+	filePath, err := writeCredentialFile(edns, secret.Data[edns.Spec.Azure.SecretRef.CredentialKey])
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer file.Close() // nolint:errcheck
-
-	b := secret.Data[edns.Spec.Azure.SecretRef.CredentialKey]
-	_, err = file.Write(b)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return &Credential{FilePath: filePath}, nil
 }
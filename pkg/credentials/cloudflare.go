@@ -19,7 +19,6 @@ package credentials
 import (
 	"context"
 	"errors"
-	"os"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -28,12 +27,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const (
-	CFBaseURL  = "CF_BASE_URL"
-	CFApiToken = "CF_API_TOKEN"
-	CFApiKey   = "CF_API_KEY"
-	CFApiEmail = "CF_API_EMAIL"
-)
+// CloudflareCredential holds the API token, or the API key and email, of the cloudflare provider
+type CloudflareCredential struct {
+	APIToken string
+	APIKey   string
+	APIEmail string
+
+	// BaseURL overrides the URL of the Cloudflare API
+	BaseURL string
+}
 
 type cfAuthMode int
 
@@ -57,34 +59,27 @@ func cfSecretMode(secret *core.Secret, tokenKey, apiKey, apiEmail string) cfAuth
 	return cfAuthInvalid
 }
 
-func setCloudflareCredentials(ctx context.Context, kc client.Client, edns *api.ExternalDNS) error {
-	// Env reset of all provider variables is done centrally in SetCredential.
+func getCloudflareCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
 	// ProviderSecretRef is required for cloudflare
 	if edns.Spec.Cloudflare == nil || edns.Spec.Cloudflare.SecretRef == nil {
-		return errors.New("providerSecretRef is not given for cloudflare provider")
+		return nil, errors.New("providerSecretRef is not given for cloudflare provider")
 	}
 
 	ref := edns.Spec.Cloudflare.SecretRef
 	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
 	if err != nil {
-		return err
-	}
-
-	if edns.Spec.Cloudflare.BaseURL != "" {
-		if err := os.Setenv(CFBaseURL, edns.Spec.Cloudflare.BaseURL); err != nil {
-			return err
-		}
+		return nil, err
 	}
 
+	cred := &CloudflareCredential{BaseURL: edns.Spec.Cloudflare.BaseURL}
 	switch cfSecretMode(secret, ref.APITokenKey, ref.APIKey, ref.APIEmailKey) {
 	case cfAuthToken:
-		return os.Setenv(CFApiToken, string(secret.Data[ref.APITokenKey]))
+		cred.APIToken = string(secret.Data[ref.APITokenKey])
 	case cfAuthKeyAndEmail:
-		if err := os.Setenv(CFApiKey, string(secret.Data[ref.APIKey])); err != nil {
-			return err
-		}
-		return os.Setenv(CFApiEmail, string(secret.Data[ref.APIEmailKey]))
+		cred.APIKey = string(secret.Data[ref.APIKey])
+		cred.APIEmail = string(secret.Data[ref.APIEmailKey])
 	default:
-		return errors.New("invalid cloudflare provider secret")
+		return nil, errors.New("invalid cloudflare provider secret")
 	}
+	return &Credential{Cloudflare: cred}, nil
 }
@@ -22,7 +22,7 @@ import (
 	core "k8s.io/api/core/v1"
 )
 
-func TestValidCFSecret(t *testing.T) {
+func TestCFSecretMode(t *testing.T) {
 	const (
 		tokenKey = "api-token"
 		apiKey   = "api-key"
@@ -32,47 +32,57 @@ func TestValidCFSecret(t *testing.T) {
 	tests := []struct {
 		name string
 		data map[string][]byte
-		want bool
+		want cfAuthMode
 	}{
 		{
 			name: "token present is sufficient",
 			data: map[string][]byte{tokenKey: []byte("tok")},
-			want: true,
+			want: cfAuthToken,
 		},
 		{
 			name: "key+email present is sufficient",
 			data: map[string][]byte{apiKey: []byte("k"), apiEmail: []byte("e@example.com")},
-			want: true,
+			want: cfAuthKeyAndEmail,
+		},
+		{
+			name: "token is preferred over key+email",
+			data: map[string][]byte{tokenKey: []byte("tok"), apiKey: []byte("k"), apiEmail: []byte("e@example.com")},
+			want: cfAuthToken,
 		},
 		{
 			name: "key only is not sufficient",
 			data: map[string][]byte{apiKey: []byte("k")},
-			want: false,
+			want: cfAuthInvalid,
 		},
 		{
 			name: "email only is not sufficient",
 			data: map[string][]byte{apiEmail: []byte("e@example.com")},
-			want: false,
+			want: cfAuthInvalid,
 		},
 		{
 			name: "empty secret is invalid",
 			data: map[string][]byte{},
-			want: false,
+			want: cfAuthInvalid,
 		},
 		{
-			// The current implementation treats a present-but-empty token
-			// key as sufficient; lock that in so the behavior is explicit.
-			name: "empty-but-present token still passes",
+			// An empty token cannot authenticate, so a present-but-empty
+			// token key does not count as a token.
+			name: "empty-but-present token is not sufficient",
 			data: map[string][]byte{tokenKey: nil},
-			want: true,
+			want: cfAuthInvalid,
+		},
+		{
+			name: "empty-but-present token falls back to key+email",
+			data: map[string][]byte{tokenKey: nil, apiKey: []byte("k"), apiEmail: []byte("e@example.com")},
+			want: cfAuthKeyAndEmail,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := validCFSecret(&core.Secret{Data: tc.data}, tokenKey, apiKey, apiEmail)
+			got := cfSecretMode(&core.Secret{Data: tc.data}, tokenKey, apiKey, apiEmail)
 			if got != tc.want {
-				t.Fatalf("validCFSecret = %v, want %v (data=%v)", got, tc.want, tc.data)
+				t.Fatalf("cfSecretMode = %v, want %v (data=%v)", got, tc.want, tc.data)
 			}
 		})
 	}
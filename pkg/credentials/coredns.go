@@ -0,0 +1,72 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EtcdCredential holds the connection settings and basic auth credentials of the etcd cluster the
+// coredns provider stores the records in. Its TLS certificates are in the TLS credential.
+type EtcdCredential struct {
+	URLs          []string
+	Username      string
+	Password      string
+	TLSServerName string
+	TLSInsecure   bool
+}
+
+func getCoreDNSCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	spec := edns.Spec.CoreDNS
+	if spec == nil {
+		return &Credential{Etcd: &EtcdCredential{}}, nil
+	}
+
+	etcd := &EtcdCredential{
+		URLs:          spec.EtcdURLs,
+		TLSServerName: ptr.Deref(spec.TLSServerName, ""),
+		TLSInsecure:   ptr.Deref(spec.TLSInsecure, false),
+	}
+	if spec.SecretRef == nil {
+		return &Credential{Etcd: etcd}, nil
+	}
+
+	ref := spec.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if etcd.Username, err = secretValue(secret, ref.UsernameKey); err != nil {
+		return nil, err
+	}
+	if etcd.Password, err = secretValue(secret, ref.PasswordKey); err != nil {
+		return nil, err
+	}
+
+	tlsCred, err := getTLSCredential(edns, secret, ref.TLSSecretKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{Etcd: etcd, TLS: tlsCred}, nil
+}
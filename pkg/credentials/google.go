@@ -19,8 +19,6 @@ package credentials
 import (
 	"context"
 	"errors"
-	"fmt"
-	"os"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -29,50 +27,29 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const GoogleApplicationCredentials = "GOOGLE_APPLICATION_CREDENTIALS"
-
 func validGoogleSecret(secret *core.Secret, key string) bool {
 	_, found := secret.Data[key]
 	return found
 }
 
-func setGoogleCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) error {
-	// Env reset of all provider variables is done centrally in SetCredential.
+func getGoogleCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
 	// if ProviderSecretRef is nil then user is intended to use Workload Identity
 	if edns.Spec.Google == nil || edns.Spec.Google.SecretRef == nil {
-		return nil
+		return &Credential{}, nil
 	}
 
 	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: edns.Spec.Google.SecretRef.Name})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !validGoogleSecret(secret, edns.Spec.Google.SecretRef.CredentialKey) {
-		return errors.New("invalid Google provider secret")
-	}
-	fileName := fmt.Sprintf("%s-%s-credential", edns.Namespace, edns.Name)
-	filePath := fmt.Sprintf("/tmp/%s", fileName)
-
-	// Remove any pre-existing file so we don't inherit looser permissions
-	// from an earlier reconcile or an older operator version.
-	_ = os.Remove(filePath)
-	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
-	if err != nil {
-		return err
+		return nil, errors.New("invalid Google provider secret")
 	}
-	defer file.Close() // nolint:errcheck
 
-	b := secret.Data[edns.Spec.Google.SecretRef.CredentialKey]
-	_, err = file.Write(b)
+	filePath, err := writeCredentialFile(edns, secret.Data[edns.Spec.Google.SecretRef.CredentialKey])
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	err = os.Setenv(GoogleApplicationCredentials, filePath)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return &Credential{FilePath: filePath}, nil
 }
@@ -0,0 +1,87 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+func getOCICredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	spec := edns.Spec.OCI
+	// with instance principal authentication the provider secret is not needed
+	if spec != nil && spec.AuthMode != nil && *spec.AuthMode == api.OCIAuthInstancePrincipal {
+		return &Credential{}, nil
+	}
+	if spec == nil || spec.SecretRef == nil {
+		return nil, errors.New("providerSecretRef is not given for oci provider")
+	}
+
+	ref := spec.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := secretValue(secret, ref.ConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	data := []byte(config)
+	if ref.PrivateKeyKey != "" {
+		key, err := secretValue(secret, ref.PrivateKeyKey)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = setOCIPrivateKey(data, key); err != nil {
+			return nil, err
+		}
+	}
+
+	// the file is used as the oci config file of this instance
+	filePath, err := writeCredentialFile(edns, data)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{FilePath: filePath}, nil
+}
+
+// setOCIPrivateKey sets the private key of the auth section of the OCI config file
+func setOCIPrivateKey(config []byte, key string) ([]byte, error) {
+	var cfg map[string]any
+	if err := yaml.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid oci config file: %w", err)
+	}
+	if cfg == nil {
+		cfg = map[string]any{}
+	}
+
+	auth, ok := cfg["auth"].(map[string]any)
+	if !ok {
+		auth = map[string]any{}
+	}
+	auth["key"] = key
+	cfg["auth"] = auth
+	return yaml.Marshal(cfg)
+}
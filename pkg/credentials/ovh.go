@@ -0,0 +1,61 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OVHCredential holds the application key, application secret and consumer key of the ovh provider
+type OVHCredential struct {
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+}
+
+func getOVHCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	if edns.Spec.OVH == nil || edns.Spec.OVH.SecretRef == nil {
+		return nil, errors.New("providerSecretRef is not given for ovh provider")
+	}
+
+	ref := edns.Spec.OVH.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.ApplicationKeyKey == "" || ref.ApplicationSecretKey == "" || ref.ConsumerKeyKey == "" {
+		return nil, errors.New("applicationKeyKey, applicationSecretKey and consumerKeyKey are required for ovh provider")
+	}
+	cred := &OVHCredential{}
+	if cred.ApplicationKey, err = secretValue(secret, ref.ApplicationKeyKey); err != nil {
+		return nil, err
+	}
+	if cred.ApplicationSecret, err = secretValue(secret, ref.ApplicationSecretKey); err != nil {
+		return nil, err
+	}
+	if cred.ConsumerKey, err = secretValue(secret, ref.ConsumerKeyKey); err != nil {
+		return nil, err
+	}
+	return &Credential{OVH: cred}, nil
+}
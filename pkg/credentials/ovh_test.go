@@ -0,0 +1,100 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOVHCredential(t *testing.T) {
+	kc := newFakeSecretClient(newTestSecret("ovh-credential", map[string]string{
+		"application-key":    "app-key",
+		"application-secret": "app-secret",
+		"consumer-key":       "consumer-key",
+	}))
+
+	tests := []struct {
+		name    string
+		ref     *api.OVHSecretReference
+		wantErr string
+	}{
+		{
+			name: "application credentials of the secret",
+			ref: &api.OVHSecretReference{
+				Name:                 "ovh-credential",
+				ApplicationKeyKey:    "application-key",
+				ApplicationSecretKey: "application-secret",
+				ConsumerKeyKey:       "consumer-key",
+			},
+		},
+		{
+			name: "missing consumer key",
+			ref: &api.OVHSecretReference{
+				Name:                 "ovh-credential",
+				ApplicationKeyKey:    "application-key",
+				ApplicationSecretKey: "application-secret",
+				ConsumerKeyKey:       "other",
+			},
+			wantErr: `key "other" is not found`,
+		},
+		{
+			name: "consumer key not referenced",
+			ref: &api.OVHSecretReference{
+				Name:                 "ovh-credential",
+				ApplicationKeyKey:    "application-key",
+				ApplicationSecretKey: "application-secret",
+			},
+			wantErr: "consumerKeyKey are required",
+		},
+		{
+			name:    "no secret reference",
+			wantErr: "providerSecretRef is not given",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edns := &api.ExternalDNS{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "ovh"},
+				Spec: api.ExternalDNSSpec{
+					Provider: api.ProviderOVH,
+					OVH:      &api.OVHProvider{SecretRef: tc.ref},
+				},
+			}
+			cred, err := GetCredential(context.Background(), kc, edns)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("GetCredential error = %v, want an error containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCredential failed: %v", err)
+			}
+			want := OVHCredential{ApplicationKey: "app-key", ApplicationSecret: "app-secret", ConsumerKey: "consumer-key"}
+			if cred.OVH == nil || *cred.OVH != want {
+				t.Fatalf("unexpected ovh credential: %+v", cred.OVH)
+			}
+		})
+	}
+}
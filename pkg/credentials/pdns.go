@@ -0,0 +1,53 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getPDNSCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	if edns.Spec.PDNS == nil || edns.Spec.PDNS.SecretRef == nil {
+		return nil, errors.New("providerSecretRef is not given for pdns provider")
+	}
+
+	ref := edns.Spec.PDNS.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.APIKeyKey == "" {
+		return nil, errors.New("apiKeyKey is required for pdns provider")
+	}
+	apiKey, err := secretValue(secret, ref.APIKeyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCred, err := getTLSCredential(edns, secret, ref.TLSSecretKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{APIKey: apiKey, TLS: tlsCred}, nil
+}
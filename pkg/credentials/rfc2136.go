@@ -0,0 +1,86 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RFC2136Credential holds the TSIG secret and the Kerberos credentials of the rfc2136 provider
+type RFC2136Credential struct {
+	TSIGSecret string
+
+	KerberosUsername string
+	KerberosPassword string
+}
+
+func getRFC2136Credential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	spec := edns.Spec.RFC2136
+	if spec == nil {
+		return nil, errors.New("rfc2136 provider information is not given")
+	}
+
+	insecure := spec.Insecure != nil && *spec.Insecure
+	gssTSIG := spec.GSSTSIG != nil && *spec.GSSTSIG
+
+	// without a secret, the updates can only be sent unsigned
+	if spec.SecretRef == nil {
+		if !insecure {
+			return nil, errors.New("providerSecretRef is not given for rfc2136 provider, it is required unless insecure is set")
+		}
+		return &Credential{RFC2136: &RFC2136Credential{}}, nil
+	}
+
+	ref := spec.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	cred := &RFC2136Credential{}
+	switch {
+	case gssTSIG:
+		if ref.KerberosUsernameKey == "" || ref.KerberosPasswordKey == "" {
+			return nil, errors.New("kerberosUsernameKey and kerberosPasswordKey are required for rfc2136 provider with gssTSIG")
+		}
+		if cred.KerberosUsername, err = secretValue(secret, ref.KerberosUsernameKey); err != nil {
+			return nil, err
+		}
+		if cred.KerberosPassword, err = secretValue(secret, ref.KerberosPasswordKey); err != nil {
+			return nil, err
+		}
+	case !insecure:
+		if ref.TSIGSecretKey == "" {
+			return nil, errors.New("tsigSecretKey is required for rfc2136 provider, unless insecure or gssTSIG is set")
+		}
+		if cred.TSIGSecret, err = secretValue(secret, ref.TSIGSecretKey); err != nil {
+			return nil, err
+		}
+	}
+
+	tlsCred, err := getTLSCredential(edns, secret, ref.TLSSecretKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{RFC2136: cred, TLS: tlsCred}, nil
+}
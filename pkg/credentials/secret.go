@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -29,6 +31,60 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// Credential holds the provider credentials of a single ExternalDNS, along with the keys of its registry. It is handed to the
+// provider construction explicitly, so reconciles of different ExternalDNS objects never
+// share credentials through the process environment.
+type Credential struct {
+	// FilePath is the file the provider secret is written to, used as the AWS shared
+	// credentials file, the Azure config file, the Google service account key, the
+	// Akamai .edgerc file, the OCI config file, the TransIP private key or the Alibaba
+	// Cloud config file
+	FilePath string
+
+	// Token holds the API token of the token based providers whose client is built by the operator
+	Token *TokenCredential
+
+	// Cloudflare holds the API token, or the API key and email, of the cloudflare provider
+	Cloudflare *CloudflareCredential
+
+	// OVH holds the application key, application secret and consumer key of the ovh provider
+	OVH *OVHCredential
+
+	// Scaleway holds the access and secret keys of the scaleway provider
+	Scaleway *ScalewayCredential
+
+	// APIKey is the API key of providers taking it from the config
+	APIKey string
+
+	// APISecret is the secret paired with APIKey, by providers authenticating with both
+	APISecret string
+
+	// Password is the password of providers logging in to their server with one
+	Password string
+
+	// BearerToken is sent with the requests of providers called over HTTP
+	BearerToken string
+
+	// TLS holds the TLS certificate files used to connect to the DNS server
+	TLS *TLSCredential
+
+	// Etcd holds the etcd connection settings and credentials of the coredns provider
+	Etcd *EtcdCredential
+
+	// RFC2136 holds the TSIG secret and Kerberos credentials of the rfc2136 provider
+	RFC2136 *RFC2136Credential
+
+	// Akamai holds the EdgeGrid tokens of the akamai provider, when it does not read them from an .edgerc file
+	Akamai *AkamaiCredential
+
+	// TXTEncryption holds the AES keys of the TXT registry, when its ownership records are encrypted
+	TXTEncryption *TXTEncryptionCredential
+
+	// SecretVersion identifies the resource versions of the secrets the credential is read from. It changes
+	// whenever one of them does, even when the credential itself only holds the path of a file.
+	SecretVersion string
+}
+
 func getSecret(ctx context.Context, kc client.Client, key types.NamespacedName) (*core.Secret, error) {
 	secret := &core.Secret{}
 	if err := kc.Get(ctx, key, secret); err != nil {
@@ -37,71 +93,146 @@ func getSecret(ctx context.Context, kc client.Client, key types.NamespacedName)
 	return secret, nil
 }
 
-func resetEnvVariables(list ...string) error {
-	for _, item := range list {
-		if err := os.Unsetenv(item); err != nil {
-			return err
-		}
-	}
-	return nil
+// credentialFilePath returns the on-disk path used by the file-based
+// provider credentials (AWS / Azure / Google).
+func credentialFilePath(edns *api.ExternalDNS) string {
+	return fmt.Sprintf("/tmp/%s-%s-credential", edns.Namespace, edns.Name)
 }
 
-// providerEnvVars enumerates every environment variable any of the
-// supported credential setters may write. Listing them in one place lets
-// SetCredential clear stale entries left over from a previous provider
-// before configuring the new one, so switching providers (or deleting an
-// old ExternalDNS and creating a new one) cannot leak credentials across
-// reconciles.
-var providerEnvVars = []string{
-	AWSSharedCredentialsFile,
-	GoogleApplicationCredentials,
-	CFBaseURL,
-	CFApiToken,
-	CFApiKey,
-	CFApiEmail,
+// writeCredentialFile writes data to the credential file of the ExternalDNS and returns its path
+func writeCredentialFile(edns *api.ExternalDNS, data []byte) (string, error) {
+	return writeFile(credentialFilePath(edns), data)
 }
 
-// credentialFilePath returns the on-disk path used by the file-based
-// provider credential setters (AWS / Azure / Google). It must stay in
-// sync with the path each setter writes to.
-func credentialFilePath(edns *api.ExternalDNS) string {
-	return fmt.Sprintf("/tmp/%s-%s-credential", edns.Namespace, edns.Name)
+// writeNamedCredentialFile writes data to a credential file of the ExternalDNS, for providers
+// needing more than one file, and returns its path
+func writeNamedCredentialFile(edns *api.ExternalDNS, name string, data []byte) (string, error) {
+	return writeFile(credentialFilePath(edns)+"-"+name, data)
+}
+
+func writeFile(filePath string, data []byte) (string, error) {
+
+	// Remove any pre-existing file so we don't inherit looser permissions
+	// from an earlier reconcile or an older operator version.
+	_ = os.Remove(filePath)
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() // nolint:errcheck
+
+	if _, err = file.Write(data); err != nil {
+		return "", err
+	}
+	return filePath, nil
 }
 
 // CleanupCredential removes any on-disk credential files written by a
-// previous SetCredential call for this ExternalDNS. Safe to call when
+// previous GetCredential call for this ExternalDNS. Safe to call when
 // the file does not exist. Intended to run during finalizer-driven
 // deletion so we don't leak secret material on /tmp across the lifetime
-// of the operator pod.
+// of the operator pod. Every known file is removed whatever the current
+// provider is, as the files may have been written for an earlier one.
 func CleanupCredential(edns *api.ExternalDNS) error {
-	switch edns.Spec.Provider {
-	case api.ProviderAWS, api.ProviderAzure, api.ProviderGoogle:
-		if err := os.Remove(credentialFilePath(edns)); err != nil && !os.IsNotExist(err) {
-			return err
-		}
+	if err := os.Remove(credentialFilePath(edns)); err != nil && !os.IsNotExist(err) {
+		return err
 	}
-	return nil
+	return cleanupTLSCredential(edns)
+}
+
+// secretVersionRecorder records the resource versions of the secrets read through it
+type secretVersionRecorder struct {
+	client.Client
+	versions map[string]string
 }
 
-func SetCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) error {
-	if err := resetEnvVariables(providerEnvVars...); err != nil {
+func (r *secretVersionRecorder) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := r.Client.Get(ctx, key, obj, opts...); err != nil {
 		return err
 	}
+	if secret, ok := obj.(*core.Secret); ok {
+		r.versions[key.Name] = secret.ResourceVersion
+	}
+	return nil
+}
 
+// secretVersion returns the recorded resource versions, sorted by secret name
+func (r *secretVersionRecorder) secretVersion() string {
+	versions := make([]string, 0, len(r.versions))
+	for name, version := range r.versions {
+		versions = append(versions, name+"="+version)
+	}
+	sort.Strings(versions)
+	return strings.Join(versions, ",")
+}
+
+// GetCredential reads the provider secret of the ExternalDNS and returns the credential to build its provider with
+func GetCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	recorder := &secretVersionRecorder{Client: kc, versions: map[string]string{}}
+	cred, err := getCredential(ctx, recorder, edns)
+	if err != nil {
+		return nil, err
+	}
+	if cred.TXTEncryption, err = getTXTEncryptionCredential(ctx, recorder, edns); err != nil {
+		return nil, err
+	}
+	cred.SecretVersion = recorder.secretVersion()
+	return cred, nil
+}
+
+func getCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
 	switch edns.Spec.Provider {
-	case api.ProviderAWS:
-		return setAWSCredential(ctx, kc, edns)
+	case api.ProviderAWS, api.ProviderAWSSD:
+		return getAWSCredential(ctx, kc, edns)
 
 	case api.ProviderCloudflare:
-		return setCloudflareCredentials(ctx, kc, edns)
+		return getCloudflareCredential(ctx, kc, edns)
 
 	case api.ProviderAzure:
-		return setAzureCredential(ctx, kc, edns)
+		return getAzureCredential(ctx, kc, edns)
 
 	case api.ProviderGoogle:
-		return setGoogleCredential(ctx, kc, edns)
+		return getGoogleCredential(ctx, kc, edns)
+
+	case api.ProviderRFC2136:
+		return getRFC2136Credential(ctx, kc, edns)
+
+	case api.ProviderPDNS:
+		return getPDNSCredential(ctx, kc, edns)
+
+	case api.ProviderCoreDNS:
+		return getCoreDNSCredential(ctx, kc, edns)
+
+	case api.ProviderWebhook:
+		return getWebhookCredential(ctx, kc, edns)
+
+	case api.ProviderDigitalOcean, api.ProviderLinode, api.ProviderScaleway, api.ProviderCivo,
+		api.ProviderDNSimple, api.ProviderGandi, api.ProviderGoDaddy, api.ProviderNS1, api.ProviderExoscale:
+		return getTokenCredential(ctx, kc, edns)
+
+	case api.ProviderAkamai:
+		return getAkamaiCredential(ctx, kc, edns)
+
+	case api.ProviderOCI:
+		return getOCICredential(ctx, kc, edns)
+
+	case api.ProviderPihole:
+		return getPiholeCredential(ctx, kc, edns)
+
+	case api.ProviderOVH:
+		return getOVHCredential(ctx, kc, edns)
+
+	case api.ProviderTransIP:
+		return getTransIPCredential(ctx, kc, edns)
+
+	case api.ProviderAlibabaCloud:
+		return getAlibabaCloudCredential(ctx, kc, edns)
+
+	case api.ProviderInMemory:
+		// the inmemory provider needs no credentials
+		return &Credential{}, nil
 
 	default:
-		return errors.New("unknown provider name")
+		return nil, errors.New("unknown provider name")
 	}
 }
@@ -0,0 +1,132 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testNamespace = "demo"
+
+// fakeSecretClient serves the Get calls of GetCredential from a fixed set of secrets
+type fakeSecretClient struct {
+	client.Client
+	secrets []*core.Secret
+}
+
+func newFakeSecretClient(secrets ...*core.Secret) client.Client {
+	return &fakeSecretClient{secrets: secrets}
+}
+
+func (c *fakeSecretClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	for _, secret := range c.secrets {
+		if secret.Namespace == key.Namespace && secret.Name == key.Name {
+			secret.DeepCopyInto(obj.(*core.Secret))
+			return nil
+		}
+	}
+	return kerr.NewNotFound(core.Resource("secrets"), key.Name)
+}
+
+func newTestSecret(name string, data map[string]string) *core.Secret {
+	secret := &core.Secret{Data: map[string][]byte{}}
+	secret.Namespace, secret.Name = testNamespace, name
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return secret
+}
+
+func TestCredentialSecretVersion(t *testing.T) {
+	secret := newTestSecret("ns1-credential", map[string]string{"api-key": "ns1-key"})
+	secret.ResourceVersion = "1"
+	kc := newFakeSecretClient(secret)
+
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "ns1"},
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderNS1,
+			NS1:      &api.NS1Provider{SecretRef: &api.TokenSecretReference{Name: "ns1-credential", TokenKey: "api-key"}},
+		},
+	}
+	cred, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	if cred.SecretVersion != "ns1-credential=1" {
+		t.Fatalf("SecretVersion = %q, want %q", cred.SecretVersion, "ns1-credential=1")
+	}
+
+	// an update of the secret changes the version of the credential
+	secret.ResourceVersion = "2"
+	if cred, err = GetCredential(context.Background(), kc, edns); err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	if cred.SecretVersion != "ns1-credential=2" {
+		t.Fatalf("SecretVersion = %q, want %q", cred.SecretVersion, "ns1-credential=2")
+	}
+}
+
+func TestCleanupCredential(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "cleanup-test"},
+		Spec:       api.ExternalDNSSpec{Provider: api.ProviderCloudflare},
+	}
+
+	// files written while the ExternalDNS used other providers
+	paths := make([]string, 0, 4)
+	filePath, err := writeCredentialFile(edns, []byte("aws"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, filePath)
+	for _, name := range []string{tlsCAFile, tlsClientCertFile, tlsClientKeyFile} {
+		filePath, err := writeNamedCredentialFile(edns, name, []byte(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, filePath)
+	}
+	t.Cleanup(func() {
+		for _, filePath := range paths {
+			_ = os.Remove(filePath)
+		}
+	})
+
+	if err := CleanupCredential(edns); err != nil {
+		t.Fatalf("CleanupCredential failed: %v", err)
+	}
+	for _, filePath := range paths {
+		if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+			t.Errorf("%s is not removed: %v", filePath, err)
+		}
+	}
+
+	// nothing is left to remove
+	if err := CleanupCredential(edns); err != nil {
+		t.Fatalf("CleanupCredential failed without files: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"fmt"
+	"os"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	tlsCAFile         = "tls-ca"
+	tlsClientCertFile = "tls-cert"
+	tlsClientKeyFile  = "tls-key"
+)
+
+// TLSCredential holds the paths of the TLS certificates written from a provider secret. The
+// upstream providers load their TLS configuration from files only.
+type TLSCredential struct {
+	CAFilePath         string
+	ClientCertFilePath string
+	ClientKeyFilePath  string
+}
+
+// secretValue returns the value of key in the secret, an empty key means the value is not used
+func secretValue(secret *core.Secret, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	value, found := secret.Data[key]
+	if !found || len(value) == 0 {
+		return "", fmt.Errorf("key %q is not found in secret %s/%s", key, secret.Namespace, secret.Name)
+	}
+	return string(value), nil
+}
+
+// getTLSCredential writes the TLS certificates referenced by keys to the credential files of the ExternalDNS
+func getTLSCredential(edns *api.ExternalDNS, secret *core.Secret, keys api.TLSSecretKeys) (*TLSCredential, error) {
+	cred := &TLSCredential{}
+	files := []struct {
+		key  string
+		name string
+		path *string
+	}{
+		{key: keys.CAKey, name: tlsCAFile, path: &cred.CAFilePath},
+		{key: keys.ClientCertKey, name: tlsClientCertFile, path: &cred.ClientCertFilePath},
+		{key: keys.ClientCertKeyKey, name: tlsClientKeyFile, path: &cred.ClientKeyFilePath},
+	}
+	for _, f := range files {
+		data, err := secretValue(secret, f.key)
+		if err != nil {
+			return nil, err
+		}
+		if data == "" {
+			continue
+		}
+		if *f.path, err = writeNamedCredentialFile(edns, f.name, []byte(data)); err != nil {
+			return nil, err
+		}
+	}
+	return cred, nil
+}
+
+// cleanupTLSCredential removes the TLS certificate files written by getTLSCredential
+func cleanupTLSCredential(edns *api.ExternalDNS) error {
+	for _, name := range []string{tlsCAFile, tlsClientCertFile, tlsClientKeyFile} {
+		if err := os.Remove(credentialFilePath(edns) + "-" + name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
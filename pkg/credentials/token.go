@@ -0,0 +1,178 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TokenCredential holds the API token of the token based providers whose client is built by the operator
+type TokenCredential struct {
+	Token string
+
+	// URL overrides the API endpoint of the linode provider
+	URL string
+
+	// SharingID is the gandi organization the token acts for
+	SharingID string
+
+	// AccountID is the dnsimple account the token acts for, looked up from the token when empty
+	AccountID string
+}
+
+// ScalewayCredential holds the access and secret keys of the scaleway provider
+type ScalewayCredential struct {
+	AccessKey string
+	SecretKey string
+
+	// APIURL overrides the URL of the Scaleway API
+	APIURL string
+
+	// PageSize is the number of records listed per request, the default of the provider when zero
+	PageSize int
+}
+
+// getTokenCredential reads the API token, or the API key and secret, of the token based providers. GoDaddy and
+// Exoscale take their key from the config, the clients of the other providers are built from the credential.
+func getTokenCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	spec := edns.Spec
+
+	switch spec.Provider {
+	case api.ProviderDigitalOcean:
+		if spec.DigitalOcean == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.DigitalOcean.SecretRef, &TokenCredential{})
+
+	case api.ProviderLinode:
+		if spec.Linode == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.Linode.SecretRef, &TokenCredential{URL: ptr.Deref(spec.Linode.URL, "")})
+
+	case api.ProviderCivo:
+		if spec.Civo == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.Civo.SecretRef, &TokenCredential{})
+
+	case api.ProviderDNSimple:
+		if spec.DNSimple == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.DNSimple.SecretRef, &TokenCredential{AccountID: ptr.Deref(spec.DNSimple.AccountID, "")})
+
+	case api.ProviderGandi:
+		if spec.Gandi == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.Gandi.SecretRef, &TokenCredential{SharingID: ptr.Deref(spec.Gandi.SharingID, "")})
+
+	case api.ProviderScaleway:
+		if spec.Scaleway == nil {
+			break
+		}
+		key, secret, err := getAPIKey(ctx, kc, edns, spec.Scaleway.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return &Credential{Scaleway: &ScalewayCredential{
+			AccessKey: key,
+			SecretKey: secret,
+			APIURL:    ptr.Deref(spec.Scaleway.APIURL, ""),
+			PageSize:  ptr.Deref(spec.Scaleway.PageSize, 0),
+		}}, nil
+
+	case api.ProviderGoDaddy:
+		if spec.GoDaddy == nil {
+			break
+		}
+		key, secret, err := getAPIKey(ctx, kc, edns, spec.GoDaddy.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return &Credential{APIKey: key, APISecret: secret}, nil
+
+	case api.ProviderNS1:
+		if spec.NS1 == nil {
+			break
+		}
+		return withTokenCredential(ctx, kc, edns, spec.NS1.SecretRef, &TokenCredential{})
+
+	case api.ProviderExoscale:
+		if spec.Exoscale == nil {
+			break
+		}
+		key, secret, err := getAPIKey(ctx, kc, edns, spec.Exoscale.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return &Credential{APIKey: key, APISecret: secret}, nil
+	}
+	return nil, fmt.Errorf("providerSecretRef is not given for %s provider", spec.Provider)
+}
+
+// withTokenCredential sets the API token of the provider secret in cred
+func withTokenCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS, ref *api.TokenSecretReference, cred *TokenCredential) (*Credential, error) {
+	token, err := getToken(ctx, kc, edns, ref)
+	if err != nil {
+		return nil, err
+	}
+	cred.Token = token
+	return &Credential{Token: cred}, nil
+}
+
+// getToken returns the API token of the provider secret
+func getToken(ctx context.Context, kc client.Client, edns *api.ExternalDNS, ref *api.TokenSecretReference) (string, error) {
+	if ref == nil {
+		return "", fmt.Errorf("providerSecretRef is not given for %s provider", edns.Spec.Provider)
+	}
+
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return "", err
+	}
+	return secretValue(secret, ref.TokenKey)
+}
+
+// getAPIKey returns the API key and the API secret of the provider secret
+func getAPIKey(ctx context.Context, kc client.Client, edns *api.ExternalDNS, ref *api.APIKeySecretReference) (string, string, error) {
+	if ref == nil {
+		return "", "", fmt.Errorf("providerSecretRef is not given for %s provider", edns.Spec.Provider)
+	}
+
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return "", "", err
+	}
+	key, err := secretValue(secret, ref.APIKeyKey)
+	if err != nil {
+		return "", "", err
+	}
+	apiSecret, err := secretValue(secret, ref.APISecretKey)
+	if err != nil {
+		return "", "", err
+	}
+	return key, apiSecret, nil
+}
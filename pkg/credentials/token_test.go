@@ -0,0 +1,155 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNS1Credential(t *testing.T) {
+	kc := newFakeSecretClient(newTestSecret("ns1-credential", map[string]string{"api-key": "ns1-key"}))
+
+	tests := []struct {
+		name    string
+		spec    *api.NS1Provider
+		want    string
+		wantErr string
+	}{
+		{
+			name: "api key of the secret",
+			spec: &api.NS1Provider{SecretRef: &api.TokenSecretReference{Name: "ns1-credential", TokenKey: "api-key"}},
+			want: "ns1-key",
+		},
+		{
+			name:    "missing key",
+			spec:    &api.NS1Provider{SecretRef: &api.TokenSecretReference{Name: "ns1-credential", TokenKey: "token"}},
+			wantErr: `key "token" is not found`,
+		},
+		{
+			name:    "missing secret",
+			spec:    &api.NS1Provider{SecretRef: &api.TokenSecretReference{Name: "other", TokenKey: "api-key"}},
+			wantErr: "not found",
+		},
+		{
+			name:    "no secret reference",
+			spec:    &api.NS1Provider{},
+			wantErr: "providerSecretRef is not given",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edns := &api.ExternalDNS{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "ns1"},
+				Spec:       api.ExternalDNSSpec{Provider: api.ProviderNS1, NS1: tc.spec},
+			}
+			cred, err := GetCredential(context.Background(), kc, edns)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("GetCredential error = %v, want an error containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCredential failed: %v", err)
+			}
+			if cred.Token == nil || cred.Token.Token != tc.want {
+				t.Fatalf("unexpected token credential: %+v", cred.Token)
+			}
+		})
+	}
+}
+
+func TestExoscaleCredential(t *testing.T) {
+	kc := newFakeSecretClient(newTestSecret("exoscale-credential", map[string]string{"key": "EXO-key", "secret": "exo-secret"}))
+
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "exoscale"},
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderExoscale,
+			Exoscale: &api.ExoscaleProvider{
+				SecretRef: &api.APIKeySecretReference{Name: "exoscale-credential", APIKeyKey: "key", APISecretKey: "secret"},
+			},
+		},
+	}
+	cred, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	if cred.APIKey != "EXO-key" || cred.APISecret != "exo-secret" {
+		t.Fatalf("unexpected credential: key %q, secret %q", cred.APIKey, cred.APISecret)
+	}
+
+	edns.Spec.Exoscale.SecretRef.APISecretKey = "other"
+	if _, err := GetCredential(context.Background(), kc, edns); err == nil {
+		t.Fatal("expected an error for a missing API secret")
+	}
+}
+
+func TestLinodeCredential(t *testing.T) {
+	kc := newFakeSecretClient(newTestSecret("linode-credential", map[string]string{"token": "linode-token"}))
+
+	url := "https://linode.example.com"
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "linode"},
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderLinode,
+			Linode: &api.LinodeProvider{
+				URL:       &url,
+				SecretRef: &api.TokenSecretReference{Name: "linode-credential", TokenKey: "token"},
+			},
+		},
+	}
+	cred, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	if cred.Token == nil || cred.Token.Token != "linode-token" || cred.Token.URL != url {
+		t.Fatalf("unexpected token credential: %+v", cred.Token)
+	}
+}
+
+func TestScalewayCredential(t *testing.T) {
+	kc := newFakeSecretClient(newTestSecret("scaleway-credential", map[string]string{"access": "SCW-access", "secret": "scw-secret"}))
+
+	url, pageSize := "https://scaleway.example.com", 100
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "scaleway"},
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderScaleway,
+			Scaleway: &api.ScalewayProvider{
+				APIURL:    &url,
+				PageSize:  &pageSize,
+				SecretRef: &api.APIKeySecretReference{Name: "scaleway-credential", APIKeyKey: "access", APISecretKey: "secret"},
+			},
+		},
+	}
+	cred, err := GetCredential(context.Background(), kc, edns)
+	if err != nil {
+		t.Fatalf("GetCredential failed: %v", err)
+	}
+	want := ScalewayCredential{AccessKey: "SCW-access", SecretKey: "scw-secret", APIURL: url, PageSize: pageSize}
+	if cred.Scaleway == nil || *cred.Scaleway != want {
+		t.Fatalf("unexpected scaleway credential: %+v", cred.Scaleway)
+	}
+}
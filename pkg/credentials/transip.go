@@ -0,0 +1,54 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getTransIPCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	if edns.Spec.TransIP == nil || edns.Spec.TransIP.SecretRef == nil {
+		return nil, errors.New("providerSecretRef is not given for transip provider")
+	}
+
+	ref := edns.Spec.TransIP.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.PrivateKeyKey == "" {
+		return nil, errors.New("privateKeyKey is required for transip provider")
+	}
+	key, err := secretValue(secret, ref.PrivateKeyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the file is used as the private key file of this instance
+	filePath, err := writeCredentialFile(edns, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{FilePath: filePath}, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// aesKeyLength is the length of the AES-256 keys the TXT registry encrypts with
+const aesKeyLength = 32
+
+// TXTEncryptionCredential holds the AES keys of the TXT registry
+type TXTEncryptionCredential struct {
+	// AESKey is the key the ownership records are encrypted with
+	AESKey []byte
+
+	// OldAESKey is the previous key while rotating it, the ownership records still encrypted with it are
+	// encrypted again with AESKey
+	OldAESKey []byte
+}
+
+// getTXTEncryptionCredential reads the AES keys of the TXT registry, when its ownership records are encrypted
+func getTXTEncryptionCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*TXTEncryptionCredential, error) {
+	encryption := edns.Spec.GetTXTEncryption()
+	if encryption == nil {
+		return nil, nil
+	}
+
+	ref := encryption.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.AESKeyKey == "" {
+		return nil, errors.New("aesKeyKey is required for TXT encryption")
+	}
+	cred := &TXTEncryptionCredential{}
+	if cred.AESKey, err = aesKey(secret, ref.AESKeyKey); err != nil {
+		return nil, err
+	}
+	if ref.OldAESKeyKey != nil && *ref.OldAESKeyKey != "" {
+		if cred.OldAESKey, err = aesKey(secret, *ref.OldAESKeyKey); err != nil {
+			return nil, err
+		}
+	}
+	return cred, nil
+}
+
+// aesKey returns the AES key of the secret, given like external-dns takes it: 32 bytes long, in plain text or
+// base64-encoded
+func aesKey(secret *core.Secret, key string) ([]byte, error) {
+	value, err := secretValue(secret, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == aesKeyLength {
+		return []byte(value), nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(decoded) != aesKeyLength {
+		return nil, fmt.Errorf("key %q of secret %s/%s must be %d bytes long, in plain text or base64-encoded", key, secret.Namespace, secret.Name, aesKeyLength)
+	}
+	return decoded, nil
+}
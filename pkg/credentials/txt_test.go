@@ -0,0 +1,102 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestTXTEncryptionCredential(t *testing.T) {
+	key := "0123456789abcdef0123456789abcdef"
+	oldKey := []byte("fedcba9876543210fedcba9876543210")
+	kc := newFakeSecretClient(newTestSecret("txt-keys", map[string]string{
+		"key":     key,
+		"old-key": base64.StdEncoding.EncodeToString(oldKey),
+		"short":   "too-short",
+	}))
+
+	tests := []struct {
+		name       string
+		ref        api.TXTEncryptionSecretReference
+		wantOldKey []byte
+		wantErr    string
+	}{
+		{
+			name: "plain text key",
+			ref:  api.TXTEncryptionSecretReference{Name: "txt-keys", AESKeyKey: "key"},
+		},
+		{
+			name:       "base64-encoded old key while rotating",
+			ref:        api.TXTEncryptionSecretReference{Name: "txt-keys", AESKeyKey: "key", OldAESKeyKey: ptr.To("old-key")},
+			wantOldKey: oldKey,
+		},
+		{
+			name:    "key of the wrong length",
+			ref:     api.TXTEncryptionSecretReference{Name: "txt-keys", AESKeyKey: "short"},
+			wantErr: "must be 32 bytes long",
+		},
+		{
+			name:    "missing old key",
+			ref:     api.TXTEncryptionSecretReference{Name: "txt-keys", AESKeyKey: "key", OldAESKeyKey: ptr.To("previous")},
+			wantErr: `key "previous" is not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edns := &api.ExternalDNS{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "txt"},
+				Spec: api.ExternalDNSSpec{
+					Provider: api.ProviderInMemory,
+					Registry: &api.Registry{
+						Type: api.RegistryTXT,
+						TXT:  &api.TXTRegistry{Encryption: &api.TXTEncryption{SecretRef: tc.ref}},
+					},
+				},
+			}
+			cred, err := GetCredential(context.Background(), kc, edns)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("GetCredential error = %v, want an error containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCredential failed: %v", err)
+			}
+			if cred.TXTEncryption == nil || string(cred.TXTEncryption.AESKey) != key {
+				t.Fatalf("unexpected TXT encryption credential: %+v", cred.TXTEncryption)
+			}
+			if !bytes.Equal(cred.TXTEncryption.OldAESKey, tc.wantOldKey) {
+				t.Fatalf("OldAESKey = %q, want %q", cred.TXTEncryption.OldAESKey, tc.wantOldKey)
+			}
+			// an update of the keys builds the registry again
+			if cred.SecretVersion == "" {
+				t.Fatal("the version of the key secret is not recorded")
+			}
+		})
+	}
+}
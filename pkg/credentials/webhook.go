@@ -0,0 +1,50 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getWebhookCredential(ctx context.Context, kc client.Client, edns *api.ExternalDNS) (*Credential, error) {
+	// the webhook provider may be reachable without any credential, e.g. as a sidecar
+	if edns.Spec.Webhook == nil || edns.Spec.Webhook.SecretRef == nil {
+		return &Credential{}, nil
+	}
+
+	ref := edns.Spec.Webhook.SecretRef
+	secret, err := getSecret(ctx, kc, types.NamespacedName{Namespace: edns.Namespace, Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := secretValue(secret, ref.TokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCred, err := getTLSCredential(edns, secret, ref.TLSSecretKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{BearerToken: token, TLS: tlsCred}, nil
+}
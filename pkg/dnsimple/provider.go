@@ -0,0 +1,327 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dnsimple implements the DNSimple provider. It follows the upstream dnsimple provider, but takes the
+// token and the account from the caller instead of the environment, so every ExternalDNS calls DNSimple with its
+// own token. The zones are always listed from the account, the DNSIMPLE_ZONES variable of upstream is not read.
+package dnsimple
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"golang.org/x/oauth2"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+const (
+	dnsimpleCreate = "CREATE"
+	dnsimpleDelete = "DELETE"
+	dnsimpleUpdate = "UPDATE"
+
+	// defaultTTL is the TTL of the records that do not set one, the default of DNSimple
+	defaultTTL = 3600
+)
+
+// client is the subset of the DNSimple zone API used by the provider
+type client interface {
+	ListZones(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) (*dnsimple.ZonesResponse, error)
+	ListRecords(ctx context.Context, accountID string, zoneID string, options *dnsimple.ZoneRecordListOptions) (*dnsimple.ZoneRecordsResponse, error)
+	CreateRecord(ctx context.Context, accountID string, zoneID string, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error)
+	DeleteRecord(ctx context.Context, accountID string, zoneID string, recordID int64) (*dnsimple.ZoneRecordResponse, error)
+	UpdateRecord(ctx context.Context, accountID string, zoneID string, recordID int64, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error)
+}
+
+// Config configures the DNSimple provider
+type Config struct {
+	Token string
+
+	// AccountID is the account the zones are managed in, it is looked up from the token when empty
+	AccountID string
+
+	// BaseURL overrides the URL of the DNSimple API, ex: the sandbox API
+	BaseURL string
+
+	DomainFilter *endpoint.DomainFilter
+	ZoneIDFilter provider.ZoneIDFilter
+	DryRun       bool
+}
+
+// Provider manages the records of the DNSimple zones of an account
+type Provider struct {
+	provider.BaseProvider
+	client       client
+	accountID    string
+	domainFilter *endpoint.DomainFilter
+	zoneIDFilter provider.ZoneIDFilter
+	dryRun       bool
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns a DNSimple provider calling the API with the token of config. When config has no account,
+// the account of the token is looked up within ctx.
+func NewProvider(ctx context.Context, config Config) (*Provider, error) {
+	if config.Token == "" {
+		return nil, errors.New("no token found for dnsimple provider")
+	}
+
+	c := dnsimple.NewClient(oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: config.Token})))
+	c.SetUserAgent(externaldns.UserAgent())
+	if config.BaseURL != "" {
+		c.BaseURL = config.BaseURL
+	}
+
+	if config.AccountID == "" {
+		whoami, err := c.Identity.Whoami(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if whoami.Data == nil || whoami.Data.Account == nil {
+			return nil, errors.New("the dnsimple token belongs to no account, set the account id")
+		}
+		config.AccountID = int64ToString(whoami.Data.Account.ID)
+	}
+	return newProvider(c.Zones, config), nil
+}
+
+func newProvider(c client, config Config) *Provider {
+	return &Provider{
+		client:       c,
+		accountID:    config.AccountID,
+		domainFilter: config.DomainFilter,
+		zoneIDFilter: config.ZoneIDFilter,
+		dryRun:       config.DryRun,
+	}
+}
+
+// Zones returns the zones of the account matching the filters, keyed by their id
+func (p *Provider) Zones(ctx context.Context) (map[string]dnsimple.Zone, error) {
+	zones := make(map[string]dnsimple.Zone)
+
+	page := 1
+	listOptions := &dnsimple.ZoneListOptions{}
+	for {
+		listOptions.Page = &page
+		resp, err := p.client.ListZones(ctx, p.accountID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range resp.Data {
+			if !p.domainFilter.Match(zone.Name) || !p.zoneIDFilter.Match(int64ToString(zone.ID)) {
+				continue
+			}
+			zones[int64ToString(zone.ID)] = zone
+		}
+
+		page++
+		if page > resp.Pagination.TotalPages {
+			break
+		}
+	}
+	return zones, nil
+}
+
+// Records returns the A, CNAME and TXT records of the zones
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*endpoint.Endpoint, 0)
+	for _, zone := range zones {
+		page := 1
+		listOptions := &dnsimple.ZoneRecordListOptions{}
+		for {
+			listOptions.Page = &page
+			records, err := p.client.ListRecords(ctx, p.accountID, zone.Name, listOptions)
+			if err != nil {
+				return nil, err
+			}
+			for _, record := range records.Data {
+				if record.Type != endpoint.RecordTypeA && record.Type != endpoint.RecordTypeCNAME && record.Type != endpoint.RecordTypeTXT {
+					continue
+				}
+				// apex records have an empty name
+				dnsName := fmt.Sprintf("%s.%s", record.Name, record.ZoneID)
+				if record.Name == "" {
+					dnsName = record.ZoneID
+				}
+				endpoints = append(endpoints, endpoint.NewEndpointWithTTL(dnsName, record.Type, endpoint.TTL(record.TTL), record.Content))
+			}
+
+			page++
+			if page > records.Pagination.TotalPages {
+				break
+			}
+		}
+	}
+	return endpoints, nil
+}
+
+// ApplyChanges creates, updates and deletes the records of changes
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	combined := make([]*dnsimpleChange, 0, len(changes.Create)+len(changes.UpdateNew)+len(changes.Delete))
+	combined = append(combined, newDnsimpleChanges(dnsimpleCreate, changes.Create)...)
+	combined = append(combined, newDnsimpleChanges(dnsimpleUpdate, changes.UpdateNew)...)
+	combined = append(combined, newDnsimpleChanges(dnsimpleDelete, changes.Delete)...)
+
+	return p.submitChanges(ctx, combined)
+}
+
+// dnsimpleChange is a record to create, update or delete
+type dnsimpleChange struct {
+	Action            string
+	ResourceRecordSet dnsimple.ZoneRecord
+}
+
+func newDnsimpleChange(action string, e *endpoint.Endpoint) *dnsimpleChange {
+	ttl := defaultTTL
+	if e.RecordTTL.IsConfigured() {
+		ttl = int(e.RecordTTL)
+	}
+
+	return &dnsimpleChange{
+		Action: action,
+		ResourceRecordSet: dnsimple.ZoneRecord{
+			Name:    e.DNSName,
+			Type:    e.RecordType,
+			Content: e.Targets[0],
+			TTL:     ttl,
+		},
+	}
+}
+
+func newDnsimpleChanges(action string, endpoints []*endpoint.Endpoint) []*dnsimpleChange {
+	changes := make([]*dnsimpleChange, 0, len(endpoints))
+	for _, e := range endpoints {
+		changes = append(changes, newDnsimpleChange(action, e))
+	}
+	return changes
+}
+
+// submitChanges applies changes to the zones of their records
+func (p *Provider) submitChanges(ctx context.Context, changes []*dnsimpleChange) error {
+	if len(changes) == 0 {
+		klog.Info("all records are already up to date")
+		return nil
+	}
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		zone := suitableZone(change.ResourceRecordSet.Name, zones)
+		if zone == nil {
+			klog.V(4).Infof("skipping record %s because no hosted zone matches its DNS name", change.ResourceRecordSet.Name)
+			continue
+		}
+
+		klog.Infof("changing records: %s %v in zone: %s", change.Action, change.ResourceRecordSet, zone.Name)
+
+		if change.ResourceRecordSet.Name == zone.Name {
+			change.ResourceRecordSet.Name = "" // apex records have an empty name
+		} else {
+			change.ResourceRecordSet.Name = strings.TrimSuffix(change.ResourceRecordSet.Name, "."+zone.Name)
+		}
+
+		recordAttributes := dnsimple.ZoneRecordAttributes{
+			Name:    &change.ResourceRecordSet.Name,
+			Type:    change.ResourceRecordSet.Type,
+			Content: change.ResourceRecordSet.Content,
+			TTL:     change.ResourceRecordSet.TTL,
+		}
+		if p.dryRun {
+			continue
+		}
+
+		switch change.Action {
+		case dnsimpleCreate:
+			if _, err := p.client.CreateRecord(ctx, p.accountID, zone.Name, recordAttributes); err != nil {
+				return err
+			}
+		case dnsimpleDelete:
+			recordID, err := p.recordID(ctx, zone.Name, *recordAttributes.Name)
+			if err != nil {
+				return err
+			}
+			if _, err = p.client.DeleteRecord(ctx, p.accountID, zone.Name, recordID); err != nil {
+				return err
+			}
+		case dnsimpleUpdate:
+			recordID, err := p.recordID(ctx, zone.Name, *recordAttributes.Name)
+			if err != nil {
+				return err
+			}
+			if _, err = p.client.UpdateRecord(ctx, p.accountID, zone.Name, recordID, recordAttributes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordID returns the id of the record named recordName in zone
+func (p *Provider) recordID(ctx context.Context, zone string, recordName string) (int64, error) {
+	page := 1
+	listOptions := &dnsimple.ZoneRecordListOptions{Name: &recordName}
+	for {
+		listOptions.Page = &page
+		records, err := p.client.ListRecords(ctx, p.accountID, zone, listOptions)
+		if err != nil {
+			return 0, err
+		}
+		for _, record := range records.Data {
+			if record.Name == recordName {
+				return record.ID, nil
+			}
+		}
+
+		page++
+		if page > records.Pagination.TotalPages {
+			break
+		}
+	}
+	return 0, fmt.Errorf("no record id found for %s in zone %s", recordName, zone)
+}
+
+// suitableZone returns the zone with the longest name that hostname is part of
+func suitableZone(hostname string, zones map[string]dnsimple.Zone) *dnsimple.Zone {
+	var zone *dnsimple.Zone
+	for _, z := range zones {
+		if strings.HasSuffix(hostname, z.Name) {
+			if zone == nil || len(z.Name) > len(zone.Name) {
+				found := z
+				zone = &found
+			}
+		}
+	}
+	return zone
+}
+
+func int64ToString(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
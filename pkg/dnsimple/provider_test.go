@@ -0,0 +1,106 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsimple
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+// startServer stands in for the DNSimple API of the account 42, accepting token only
+func startServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/whoami":
+			_, _ = fmt.Fprint(w, `{"data":{"account":{"id":42}}}`)
+		case "/v2/42/zones":
+			_, _ = fmt.Fprint(w, `{"data":[{"id":1,"name":"example.com"},{"id":2,"name":"example.org"}],"pagination":{"total_pages":1}}`)
+		case "/v2/42/zones/example.com/records":
+			_, _ = fmt.Fprint(w, `{"data":[{"id":7,"zone_id":"example.com","name":"www","type":"A","content":"192.0.2.1","ttl":300}],"pagination":{"total_pages":1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProviderLooksUpAccount(t *testing.T) {
+	server := startServer(t, "token")
+
+	p, err := NewProvider(context.Background(), Config{
+		Token:        "token",
+		BaseURL:      server.URL,
+		DomainFilter: endpoint.NewDomainFilter([]string{"example.com"}),
+	})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	if p.accountID != "42" {
+		t.Fatalf("account = %q, want the account of the token", p.accountID)
+	}
+
+	records, err := p.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) != 1 || records[0].DNSName != "www.example.com" || records[0].Targets[0] != "192.0.2.1" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+
+	if _, err := NewProvider(context.Background(), Config{Token: "other", BaseURL: server.URL}); err == nil {
+		t.Fatal("expected an error with a token of no account")
+	}
+	if _, err := NewProvider(context.Background(), Config{}); err == nil {
+		t.Fatal("expected an error without a token")
+	}
+}
+
+func TestProviderWithAccount(t *testing.T) {
+	server := startServer(t, "token")
+
+	p, err := NewProvider(context.Background(), Config{
+		Token:        "token",
+		AccountID:    "42",
+		BaseURL:      server.URL,
+		DomainFilter: endpoint.NewDomainFilter(nil),
+		ZoneIDFilter: provider.NewZoneIDFilter([]string{"2"}),
+	})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	zones, err := p.Zones(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list zones: %v", err)
+	}
+	if len(zones) != 1 || zones["2"].Name != "example.org" {
+		t.Fatalf("unexpected zones: %v", zones)
+	}
+}
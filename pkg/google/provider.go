@@ -0,0 +1,441 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package google implements the Google Cloud DNS provider. It follows the upstream google provider, but takes the
+// service account key file from the caller instead of the GOOGLE_APPLICATION_CREDENTIALS variable, so every
+// ExternalDNS calls Cloud DNS with its own service account. Without a key file, the application default credentials
+// of the operator are used, ex: Workload Identity.
+package google
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/compute/metadata"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	extdnshttp "sigs.k8s.io/external-dns/pkg/http"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+// defaultTTL is the TTL of the records that do not set one
+const defaultTTL = 300
+
+type managedZonesListCall interface {
+	Pages(ctx context.Context, f func(*dns.ManagedZonesListResponse) error) error
+}
+
+type managedZonesClient interface {
+	List(project string) managedZonesListCall
+}
+
+type resourceRecordSetsListCall interface {
+	Pages(ctx context.Context, f func(*dns.ResourceRecordSetsListResponse) error) error
+}
+
+type resourceRecordSetsClient interface {
+	List(project string, managedZone string) resourceRecordSetsListCall
+}
+
+type changesCreateCall interface {
+	Do(opts ...googleapi.CallOption) (*dns.Change, error)
+}
+
+type changesClient interface {
+	Create(project string, managedZone string, change *dns.Change) changesCreateCall
+}
+
+type managedZonesService struct {
+	service *dns.ManagedZonesService
+}
+
+func (m managedZonesService) List(project string) managedZonesListCall {
+	return m.service.List(project)
+}
+
+type resourceRecordSetsService struct {
+	service *dns.ResourceRecordSetsService
+}
+
+func (r resourceRecordSetsService) List(project string, managedZone string) resourceRecordSetsListCall {
+	return r.service.List(project, managedZone)
+}
+
+type changesService struct {
+	service *dns.ChangesService
+}
+
+func (c changesService) Create(project string, managedZone string, change *dns.Change) changesCreateCall {
+	return c.service.Create(project, managedZone, change)
+}
+
+// Config configures the Google Cloud DNS provider
+type Config struct {
+	// Project is the project of the zones. When empty, it is the project of the service account key, or the
+	// project of the instance the operator runs on.
+	Project string
+
+	// CredentialsFile is the service account key file, the application default credentials are used when empty
+	CredentialsFile string
+
+	// BatchChangeSize is the maximum number of changes sent in one request, and BatchChangeInterval the pause
+	// between two requests
+	BatchChangeSize     int
+	BatchChangeInterval time.Duration
+
+	// ZoneVisibility selects the public or the private zones, both when empty
+	ZoneVisibility string
+
+	DomainFilter *endpoint.DomainFilter
+	ZoneIDFilter provider.ZoneIDFilter
+	DryRun       bool
+}
+
+// Provider manages the records of the Cloud DNS zones of a project
+type Provider struct {
+	provider.BaseProvider
+
+	project             string
+	dryRun              bool
+	batchChangeSize     int
+	batchChangeInterval time.Duration
+	domainFilter        *endpoint.DomainFilter
+	zoneTypeFilter      provider.ZoneTypeFilter
+	zoneIDFilter        provider.ZoneIDFilter
+
+	resourceRecordSetsClient resourceRecordSetsClient
+	managedZonesClient       managedZonesClient
+	changesClient            changesClient
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns a Google Cloud DNS provider calling the API with the service account of config
+func NewProvider(ctx context.Context, config Config) (*Provider, error) {
+	gcloud, project, err := newHTTPClient(ctx, config.CredentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsClient, err := dns.NewService(ctx, option.WithHTTPClient(extdnshttp.NewInstrumentedClient(gcloud)))
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Project == "" {
+		config.Project = project
+	}
+	if config.Project == "" {
+		config.Project, err = metadata.ProjectIDWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to auto-detect the project id: %w", err)
+		}
+		klog.Infof("google project auto-detected: %s", config.Project)
+	}
+
+	return newProvider(
+		resourceRecordSetsService{dnsClient.ResourceRecordSets},
+		managedZonesService{dnsClient.ManagedZones},
+		changesService{dnsClient.Changes},
+		config,
+	), nil
+}
+
+// newHTTPClient returns the client authenticated with the service account key file, and the project of the key.
+// Without a key file, the client uses the application default credentials.
+func newHTTPClient(ctx context.Context, credentialsFile string) (*http.Client, string, error) {
+	if credentialsFile == "" {
+		c, err := google.DefaultClient(ctx, dns.NdevClouddnsReadwriteScope)
+		return c, "", err
+	}
+
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, "", err
+	}
+	creds, err := google.CredentialsFromJSON(ctx, data, dns.NdevClouddnsReadwriteScope)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid google service account key: %w", err)
+	}
+	return oauth2.NewClient(ctx, creds.TokenSource), creds.ProjectID, nil
+}
+
+func newProvider(records resourceRecordSetsClient, zones managedZonesClient, changes changesClient, config Config) *Provider {
+	return &Provider{
+		project:                  config.Project,
+		dryRun:                   config.DryRun,
+		batchChangeSize:          config.BatchChangeSize,
+		batchChangeInterval:      config.BatchChangeInterval,
+		domainFilter:             config.DomainFilter,
+		zoneTypeFilter:           provider.NewZoneTypeFilter(config.ZoneVisibility),
+		zoneIDFilter:             config.ZoneIDFilter,
+		resourceRecordSetsClient: records,
+		managedZonesClient:       zones,
+		changesClient:            changes,
+	}
+}
+
+// Zones returns the zones matching the filters, keyed by their name. Peering zones are skipped.
+func (p *Provider) Zones(ctx context.Context) (map[string]*dns.ManagedZone, error) {
+	zones := make(map[string]*dns.ManagedZone)
+
+	f := func(resp *dns.ManagedZonesListResponse) error {
+		for _, zone := range resp.ManagedZones {
+			if zone.PeeringConfig != nil {
+				klog.V(4).Infof("filtered peering zone %s (zone: %s)", zone.DnsName, zone.Name)
+				continue
+			}
+			if p.domainFilter.Match(zone.DnsName) && p.zoneTypeFilter.Match(zone.Visibility) &&
+				(p.zoneIDFilter.Match(fmt.Sprintf("%v", zone.Id)) || p.zoneIDFilter.Match(zone.Name)) {
+				zones[zone.Name] = zone
+				klog.V(4).Infof("matched %s (zone: %s) (visibility: %s)", zone.DnsName, zone.Name, zone.Visibility)
+			} else {
+				klog.V(4).Infof("filtered %s (zone: %s) (visibility: %s)", zone.DnsName, zone.Name, zone.Visibility)
+			}
+		}
+		return nil
+	}
+
+	if err := p.managedZonesClient.List(p.project).Pages(ctx, f); err != nil {
+		return nil, provider.NewSoftError(fmt.Errorf("failed to list zones: %w", err))
+	}
+	if len(zones) == 0 {
+		klog.Warningf("no zones in the project %s match the domain filter %v", p.project, p.domainFilter)
+	}
+	return zones, nil
+}
+
+// Records returns the records of the zones
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*endpoint.Endpoint, 0)
+	f := func(resp *dns.ResourceRecordSetsListResponse) error {
+		for _, r := range resp.Rrsets {
+			if !p.SupportedRecordType(r.Type) {
+				continue
+			}
+			endpoints = append(endpoints, endpoint.NewEndpointWithTTL(r.Name, r.Type, endpoint.TTL(r.Ttl), r.Rrdatas...))
+		}
+		return nil
+	}
+
+	for _, z := range zones {
+		if err := p.resourceRecordSetsClient.List(p.project, z.Name).Pages(ctx, f); err != nil {
+			return nil, provider.NewSoftErrorf("failed to list records in zone %s: %v", z.Name, err)
+		}
+	}
+	return endpoints, nil
+}
+
+// ApplyChanges sends changes to the zones of their records
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	change := &dns.Change{}
+	change.Additions = append(change.Additions, p.newFilteredRecords(changes.Create)...)
+	change.Additions = append(change.Additions, p.newFilteredRecords(changes.UpdateNew)...)
+	change.Deletions = append(change.Deletions, p.newFilteredRecords(changes.UpdateOld)...)
+	change.Deletions = append(change.Deletions, p.newFilteredRecords(changes.Delete)...)
+
+	return p.submitChange(ctx, change)
+}
+
+// SupportedRecordType returns true if the record type is supported by the provider
+func (p *Provider) SupportedRecordType(recordType string) bool {
+	switch recordType {
+	case endpoint.RecordTypeMX:
+		return true
+	default:
+		return provider.SupportedRecordType(recordType)
+	}
+}
+
+// newFilteredRecords returns the record sets of the endpoints matching the domain filter
+func (p *Provider) newFilteredRecords(endpoints []*endpoint.Endpoint) []*dns.ResourceRecordSet {
+	var records []*dns.ResourceRecordSet
+	for _, ep := range endpoints {
+		if p.domainFilter.Match(ep.DNSName) {
+			records = append(records, newRecord(ep))
+		}
+	}
+	return records
+}
+
+// submitChange sends change to the zones of its records, in batches
+func (p *Provider) submitChange(ctx context.Context, change *dns.Change) error {
+	if len(change.Additions) == 0 && len(change.Deletions) == 0 {
+		klog.Info("all records are already up to date")
+		return nil
+	}
+
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return err
+	}
+
+	for zone, change := range separateChange(zones, change) {
+		for batch, c := range batchChange(change, p.batchChangeSize) {
+			for _, del := range c.Deletions {
+				klog.InfoS("deleting records", "zone", zone, "batch", batch, "name", del.Name, "type", del.Type, "rrdatas", del.Rrdatas, "ttl", del.Ttl)
+			}
+			for _, add := range c.Additions {
+				klog.InfoS("adding records", "zone", zone, "batch", batch, "name", add.Name, "type", add.Type, "rrdatas", add.Rrdatas, "ttl", add.Ttl)
+			}
+			if p.dryRun {
+				continue
+			}
+
+			if _, err := p.changesClient.Create(p.project, zone, c).Do(); err != nil {
+				return provider.NewSoftError(fmt.Errorf("failed to create changes: %w", err))
+			}
+			time.Sleep(p.batchChangeInterval)
+		}
+	}
+	return nil
+}
+
+// batchChange splits change into changes of at most batchSize records. The records of a name are kept in one
+// change, names with more records than batchSize are skipped.
+func batchChange(change *dns.Change, batchSize int) []*dns.Change {
+	if batchSize == 0 {
+		return []*dns.Change{change}
+	}
+
+	type nameChange struct {
+		additions []*dns.ResourceRecordSet
+		deletions []*dns.ResourceRecordSet
+	}
+	changesByName := map[string]*nameChange{}
+	byName := func(name string) *nameChange {
+		c, ok := changesByName[name]
+		if !ok {
+			c = &nameChange{}
+			changesByName[name] = c
+		}
+		return c
+	}
+	for _, a := range change.Additions {
+		c := byName(a.Name)
+		c.additions = append(c.additions, a)
+	}
+	for _, d := range change.Deletions {
+		c := byName(d.Name)
+		c.deletions = append(c.deletions, d)
+	}
+
+	names := make([]string, 0, len(changesByName))
+	for name := range changesByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []*dns.Change
+	current := &dns.Change{}
+	var total int
+	for _, name := range names {
+		c := changesByName[name]
+		count := len(c.additions) + len(c.deletions)
+		if count > batchSize {
+			klog.Warningf("total changes for %s exceeds max batch size of %d, total changes: %d", name, batchSize, count)
+			continue
+		}
+		if total+count > batchSize {
+			changes = append(changes, current)
+			current = &dns.Change{}
+			total = 0
+		}
+		current.Additions = append(current.Additions, c.additions...)
+		current.Deletions = append(current.Deletions, c.deletions...)
+		total += count
+	}
+	if total > 0 {
+		changes = append(changes, current)
+	}
+	return changes
+}
+
+// separateChange splits change into the changes of every zone, keyed by the zone name
+func separateChange(zones map[string]*dns.ManagedZone, change *dns.Change) map[string]*dns.Change {
+	changes := make(map[string]*dns.Change)
+	zoneNameIDMapper := provider.ZoneIDName{}
+	for _, z := range zones {
+		zoneNameIDMapper[z.Name] = z.DnsName
+		changes[z.Name] = &dns.Change{
+			Additions: []*dns.ResourceRecordSet{},
+			Deletions: []*dns.ResourceRecordSet{},
+		}
+	}
+
+	for _, a := range change.Additions {
+		if zoneName, _ := zoneNameIDMapper.FindZone(provider.EnsureTrailingDot(a.Name)); zoneName != "" {
+			changes[zoneName].Additions = append(changes[zoneName].Additions, a)
+		} else {
+			klog.Warningf("no matching zone for record addition: %s %s %s %d", a.Name, a.Type, a.Rrdatas, a.Ttl)
+		}
+	}
+	for _, d := range change.Deletions {
+		if zoneName, _ := zoneNameIDMapper.FindZone(provider.EnsureTrailingDot(d.Name)); zoneName != "" {
+			changes[zoneName].Deletions = append(changes[zoneName].Deletions, d)
+		} else {
+			klog.Warningf("no matching zone for record deletion: %s %s %s %d", d.Name, d.Type, d.Rrdatas, d.Ttl)
+		}
+	}
+
+	for zone, change := range changes {
+		if len(change.Additions) == 0 && len(change.Deletions) == 0 {
+			delete(changes, zone)
+		}
+	}
+	return changes
+}
+
+// newRecord returns the record set of ep. The names of the CNAME, MX, SRV and NS targets are fully qualified.
+func newRecord(ep *endpoint.Endpoint) *dns.ResourceRecordSet {
+	targets := make([]string, len(ep.Targets))
+	copy(targets, ep.Targets)
+	switch ep.RecordType {
+	case endpoint.RecordTypeCNAME:
+		targets[0] = provider.EnsureTrailingDot(targets[0])
+	case endpoint.RecordTypeMX, endpoint.RecordTypeSRV, endpoint.RecordTypeNS:
+		for i := range targets {
+			targets[i] = provider.EnsureTrailingDot(targets[i])
+		}
+	}
+
+	var ttl int64 = defaultTTL
+	if ep.RecordTTL.IsConfigured() {
+		ttl = int64(ep.RecordTTL)
+	}
+
+	return &dns.ResourceRecordSet{
+		Name:    provider.EnsureTrailingDot(ep.DNSName),
+		Rrdatas: targets,
+		Ttl:     ttl,
+		Type:    ep.RecordType,
+	}
+}
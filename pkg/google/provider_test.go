@@ -0,0 +1,170 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package google
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+type fakeZones struct{}
+
+func (fakeZones) List(string) managedZonesListCall { return fakeZones{} }
+
+func (fakeZones) Pages(_ context.Context, f func(*dns.ManagedZonesListResponse) error) error {
+	return f(&dns.ManagedZonesListResponse{ManagedZones: []*dns.ManagedZone{
+		{Id: 1, Name: "example-com", DnsName: "example.com.", Visibility: "public"},
+		{Id: 2, Name: "internal-example-com", DnsName: "internal.example.com.", Visibility: "private"},
+		{Id: 3, Name: "peered", DnsName: "peered.example.com.", PeeringConfig: &dns.ManagedZonePeeringConfig{}},
+	}})
+}
+
+type fakeRecords struct {
+	zone string
+}
+
+func (fakeRecords) List(_ string, zone string) resourceRecordSetsListCall {
+	return fakeRecords{zone: zone}
+}
+
+func (r fakeRecords) Pages(_ context.Context, f func(*dns.ResourceRecordSetsListResponse) error) error {
+	if r.zone != "example-com" {
+		return nil
+	}
+	return f(&dns.ResourceRecordSetsListResponse{Rrsets: []*dns.ResourceRecordSet{
+		{Name: "www.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1"}},
+		{Name: "example.com.", Type: "MX", Ttl: 300, Rrdatas: []string{"10 mail.example.com."}},
+		{Name: "example.com.", Type: "SOA", Ttl: 300, Rrdatas: []string{"ns.example.com. admin.example.com. 1 21600 3600 259200 300"}},
+	}})
+}
+
+// fakeChanges records the changes sent to every zone
+type fakeChanges struct {
+	created map[string][]*dns.Change
+}
+
+type fakeChangesCall struct {
+	change *dns.Change
+}
+
+func (c fakeChangesCall) Do(...googleapi.CallOption) (*dns.Change, error) { return c.change, nil }
+
+func (c *fakeChanges) Create(_ string, zone string, change *dns.Change) changesCreateCall {
+	c.created[zone] = append(c.created[zone], change)
+	return fakeChangesCall{change: change}
+}
+
+func TestProviderRecords(t *testing.T) {
+	p := newProvider(fakeRecords{}, fakeZones{}, &fakeChanges{}, Config{
+		Project:        "project",
+		ZoneVisibility: "public",
+		DomainFilter:   endpoint.NewDomainFilter(nil),
+		ZoneIDFilter:   provider.NewZoneIDFilter(nil),
+	})
+
+	zones, err := p.Zones(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list zones: %v", err)
+	}
+	if len(zones) != 1 || zones["example-com"] == nil {
+		t.Fatalf("unexpected zones: %v", zones)
+	}
+
+	records, err := p.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	// the MX records are supported, the SOA records are not
+	if len(records) != 2 {
+		t.Fatalf("unexpected records: %v", records)
+	}
+}
+
+func TestProviderApplyChanges(t *testing.T) {
+	changes := &fakeChanges{created: map[string][]*dns.Change{}}
+	p := newProvider(fakeRecords{}, fakeZones{}, changes, Config{
+		Project:         "project",
+		BatchChangeSize: 1,
+		DomainFilter:    endpoint.NewDomainFilter(nil),
+		ZoneIDFilter:    provider.NewZoneIDFilter(nil),
+	})
+
+	err := p.ApplyChanges(context.Background(), &plan.Changes{
+		Create: []*endpoint.Endpoint{
+			endpoint.NewEndpoint("api.example.com", endpoint.RecordTypeCNAME, "www.example.com"),
+			endpoint.NewEndpointWithTTL("db.internal.example.com", endpoint.RecordTypeA, 60, "10.0.0.1"),
+			endpoint.NewEndpoint("www.example.org", endpoint.RecordTypeA, "192.0.2.1"),
+		},
+		Delete: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1")},
+	})
+	if err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+
+	// every change of example.com is sent in its own batch, the record of no zone is skipped
+	if len(changes.created) != 2 || len(changes.created["example-com"]) != 2 || len(changes.created["internal-example-com"]) != 1 {
+		t.Fatalf("unexpected changes: %v", changes.created)
+	}
+	for _, c := range changes.created["example-com"] {
+		if len(c.Additions) == 1 && c.Additions[0].Rrdatas[0] != "www.example.com." {
+			t.Fatalf("CNAME target %q is not fully qualified", c.Additions[0].Rrdatas[0])
+		}
+	}
+	if ttl := changes.created["internal-example-com"][0].Additions[0].Ttl; ttl != 60 {
+		t.Fatalf("ttl = %d, want 60", ttl)
+	}
+}
+
+func TestNewProviderWithKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key.json")
+	key := `{"type":"service_account","project_id":"key-project","client_email":"edns@key-project.iam.gserviceaccount.com","private_key":"key","token_uri":"https://oauth2.googleapis.com/token"}`
+	if err := os.WriteFile(keyFile, []byte(key), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewProvider(context.Background(), Config{CredentialsFile: keyFile})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	if p.project != "key-project" {
+		t.Fatalf("project = %q, want the project of the key", p.project)
+	}
+
+	p, err = NewProvider(context.Background(), Config{Project: "project", CredentialsFile: keyFile})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	if p.project != "project" {
+		t.Fatalf("project = %q, want the project of the config", p.project)
+	}
+
+	if err := os.WriteFile(keyFile, []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProvider(context.Background(), Config{Project: "project", CredentialsFile: keyFile}); err == nil {
+		t.Fatal("expected an error with an invalid key file")
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"context"
+	"maps"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// getKindDNSEndpoint watches the custom resources read by the crd source. The kind is
+// configurable, so they are watched through unstructured objects.
+func getKindDNSEndpoint(cache cache.Cache, r client.Client, gvk schema.GroupVersionKind) (source.SyncingSource, error) {
+	if err := ensureServed(r, gvk); err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *unstructured.Unstructured) []reconcile.Request {
+		return ednsRequests(ctx, r, func(src api.SourceConfig) bool {
+			if !src.IsCRDSource() {
+				return false
+			}
+			srcGVK, err := src.CRDSourceGroupVersionKind()
+			return err == nil && srcGVK.GroupKind() == gvk.GroupKind()
+		})
+	})
+	return source.Kind(cache, obj, hdlr, predicate.TypedFuncs[*unstructured.Unstructured]{UpdateFunc: func(e event.TypedUpdateEvent[*unstructured.Unstructured]) bool {
+		// The crd source writes status.observedGeneration back on every sync, so status
+		// updates are ignored; only spec (bumps Generation), annotations and labels count.
+		return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() ||
+			!maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations()) ||
+			!maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	}}), nil
+}
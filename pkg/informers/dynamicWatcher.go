@@ -18,11 +18,13 @@ package informers
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -30,39 +32,79 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type ObjectTracker struct {
-	m sync.Map
+	// mu serializes the registrations, so a concurrent reconcile waits for a watch being started
+	// instead of skipping it
+	mu sync.Mutex
+	m  sync.Map
 
 	Manager manager.Manager
 	controller.Controller
 }
 
 func (o *ObjectTracker) Watch(obj runtime.Object, r client.Client) error {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return o.watch(gvk, func() (source.SyncingSource, error) {
+		return getKind(r, gvk, o.Manager.GetCache())
+	})
+}
+
+// watch registers the source built by getSource once per GroupKind
+func (o *ObjectTracker) watch(gvk schema.GroupVersionKind, getSource func() (source.SyncingSource, error)) error {
 	if o.Controller == nil {
 		return nil
 	}
 
-	gvk := obj.GetObjectKind().GroupVersionKind()
-	key := gvk.GroupKind().String()
+	o.mu.Lock()
+	defer o.mu.Unlock()
 
-	if _, loaded := o.m.LoadOrStore(key, struct{}{}); loaded {
+	key := gvk.GroupKind().String()
+	if _, loaded := o.m.Load(key); loaded {
 		return nil
 	}
 
-	u := &unstructured.Unstructured{}
-	u.SetGroupVersionKind(gvk)
-
-	kind, err := getKind(r, gvk, o.Manager.GetCache())
+	kind, err := getSource()
 	if err != nil {
 		klog.Error(err, "unable to watch object "+gvk.String())
 		return err
 	}
 	if err = o.Controller.Watch(kind); err != nil {
-		o.m.Delete(key)
 		return errors.Wrapf(err, "failed to add watcher on external object %q", gvk.String())
 	}
+	// the GroupKind is only recorded once its watch is started, a failed attempt is retried
+	o.m.Store(key, struct{}{})
+	return nil
+}
+
+// CRDNotInstalledError is returned when the source type of an ExternalDNS is backed by a
+// CRD that is not installed in the cluster.
+type CRDNotInstalledError struct {
+	GVK schema.GroupVersionKind
+}
+
+func (e *CRDNotInstalledError) Error() string {
+	return fmt.Sprintf("%s is not served by the cluster, install its CRD to use it as a source", e.GVK)
+}
+
+// IsCRDNotInstalled reports whether err is caused by a missing source CRD
+func IsCRDNotInstalled(err error) bool {
+	var target *CRDNotInstalledError
+	return errors.As(err, &target)
+}
+
+// ensureServed checks that the API server knows gvk, so a missing CRD is reported instead of
+// leaving an informer retrying forever in the background.
+func ensureServed(r client.Client, gvk schema.GroupVersionKind) error {
+	if _, err := r.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return &CRDNotInstalledError{GVK: gvk}
+		}
+		return err
+	}
 	return nil
 }
 
@@ -73,5 +115,43 @@ func getRuntimeObject(gvk schema.GroupVersionKind) runtime.Object {
 }
 
 func RegisterWatcher(ctx context.Context, crd *api.ExternalDNS, watcher *ObjectTracker, r client.Client) error {
-	return watcher.Watch(getRuntimeObject(crd.Spec.Source.Type.GroupVersionKind()), r)
+	srcs := crd.Spec.GetSources()
+	if len(srcs) == 0 {
+		return errors.New("no source specified, set either source or sources")
+	}
+	for _, src := range srcs {
+		if err := registerSourceWatcher(src, watcher, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func registerSourceWatcher(src api.SourceConfig, watcher *ObjectTracker, r client.Client) error {
+	if src.IsCRDSource() {
+		gvk, err := src.CRDSourceGroupVersionKind()
+		if err != nil {
+			return err
+		}
+		return watcher.watch(gvk, func() (source.SyncingSource, error) {
+			return getKindDNSEndpoint(watcher.Manager.GetCache(), r, gvk)
+		})
+	}
+
+	gvk := src.Type.GroupVersionKind()
+	if err := watcher.Watch(getRuntimeObject(gvk), r); err != nil {
+		return err
+	}
+
+	switch {
+	case IsIstioKind(src.Type):
+		// virtual services take their targets from the Istio Gateway they are bound to
+		if gvk.Kind == kindVirtualService {
+			return watcher.Watch(getRuntimeObject(gvk.GroupVersion().WithKind(kindIstioGateway)), r)
+		}
+	case IsGatewayRouteKind(gvk.Kind):
+		// route endpoints take their targets from the status addresses of the parent Gateway
+		return watcher.Watch(getRuntimeObject(gwapiv1.SchemeGroupVersion.WithKind(kindGateway)), r)
+	}
+	return nil
 }
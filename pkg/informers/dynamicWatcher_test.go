@@ -0,0 +1,84 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+func TestRegisterWatcherWithoutSources(t *testing.T) {
+	edns := &api.ExternalDNS{Spec: api.ExternalDNSSpec{Provider: api.ProviderAWS}}
+	if err := RegisterWatcher(context.Background(), edns, &ObjectTracker{}, nil); err == nil {
+		t.Fatal("expected an error when neither source nor sources is set")
+	}
+}
+
+// watchController counts the sources it is asked to watch, failing the first calls while failures is positive
+type watchController struct {
+	controller.Controller
+	mu       sync.Mutex
+	failures int
+	watches  int
+}
+
+func (c *watchController) Watch(source.TypedSource[reconcile.Request]) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("watch failed")
+	}
+	c.watches++
+	return nil
+}
+
+func TestObjectTrackerWatch(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Service"}
+	getSource := func() (source.SyncingSource, error) { return nil, nil }
+
+	ctrl := &watchController{failures: 1}
+	tracker := &ObjectTracker{Controller: ctrl}
+	if err := tracker.watch(gvk, getSource); err == nil {
+		t.Fatal("expected the failed watch to be reported")
+	}
+
+	// the failed GroupKind is registered again, exactly once by concurrent reconciles
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := tracker.watch(gvk, getSource); err != nil {
+				t.Errorf("failed to watch %s: %v", gvk, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if ctrl.watches != 1 {
+		t.Fatalf("expected a single watch for %s, got %d", gvk, ctrl.watches)
+	}
+}
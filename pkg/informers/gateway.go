@@ -0,0 +1,153 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"context"
+	"maps"
+	"reflect"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	kindGateway   = "Gateway"
+	kindHTTPRoute = "HTTPRoute"
+	kindGRPCRoute = "GRPCRoute"
+	kindTLSRoute  = "TLSRoute"
+	kindTCPRoute  = "TCPRoute"
+	kindUDPRoute  = "UDPRoute"
+)
+
+// IsGatewayRouteKind reports whether kind is one of the Gateway API route kinds
+// external-dns can read endpoints from.
+func IsGatewayRouteKind(kind string) bool {
+	switch kind {
+	case kindHTTPRoute, kindGRPCRoute, kindTLSRoute, kindTCPRoute, kindUDPRoute:
+		return true
+	}
+	return false
+}
+
+func gatewayRouteRequests(ctx context.Context, r client.Client, kind string) []reconcile.Request {
+	return ednsRequests(ctx, r, func(src api.SourceConfig) bool { return src.Type.Kind == kind })
+}
+
+// routeChanged reports whether a route update affects the endpoints external-dns derives
+// from it: spec changes (bumps Generation), annotations (hostname/ttl/etc.), labels, or the
+// parent status, which decides whether the route is accepted by a Gateway.
+func routeChanged(oldObj, newObj client.Object, oldParents, newParents []gwapiv1.RouteParentStatus) bool {
+	return oldObj.GetGeneration() != newObj.GetGeneration() ||
+		!maps.Equal(oldObj.GetAnnotations(), newObj.GetAnnotations()) ||
+		!maps.Equal(oldObj.GetLabels(), newObj.GetLabels()) ||
+		!reflect.DeepEqual(oldParents, newParents)
+}
+
+func getKindGateway(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1.SchemeGroupVersion.WithKind(kindGateway)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1.Gateway) []reconcile.Request {
+		// Gateway addresses are the targets of every route attached to it, so any
+		// ExternalDNS reading routes has to be refreshed.
+		return ednsRequests(ctx, r, func(src api.SourceConfig) bool { return IsGatewayRouteKind(src.Type.Kind) })
+	})
+	return source.Kind(cache, &gwapiv1.Gateway{}, hdlr, predicate.TypedFuncs[*gwapiv1.Gateway]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1.Gateway]) bool {
+		// Reconcile only on listener changes (bumps Generation), annotations,
+		// labels (gateway label filter) or the addresses published in status.
+		return e.ObjectOld.Generation != e.ObjectNew.Generation ||
+			!maps.Equal(e.ObjectOld.Annotations, e.ObjectNew.Annotations) ||
+			!maps.Equal(e.ObjectOld.Labels, e.ObjectNew.Labels) ||
+			!reflect.DeepEqual(e.ObjectOld.Status.Addresses, e.ObjectNew.Status.Addresses)
+	}}), nil
+}
+
+func getKindHTTPRoute(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1.SchemeGroupVersion.WithKind(kindHTTPRoute)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1.HTTPRoute) []reconcile.Request {
+		return gatewayRouteRequests(ctx, r, kindHTTPRoute)
+	})
+	return source.Kind(cache, &gwapiv1.HTTPRoute{}, hdlr, predicate.TypedFuncs[*gwapiv1.HTTPRoute]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1.HTTPRoute]) bool {
+		return routeChanged(e.ObjectOld, e.ObjectNew, e.ObjectOld.Status.Parents, e.ObjectNew.Status.Parents)
+	}}), nil
+}
+
+func getKindGRPCRoute(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1.SchemeGroupVersion.WithKind(kindGRPCRoute)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1.GRPCRoute) []reconcile.Request {
+		return gatewayRouteRequests(ctx, r, kindGRPCRoute)
+	})
+	return source.Kind(cache, &gwapiv1.GRPCRoute{}, hdlr, predicate.TypedFuncs[*gwapiv1.GRPCRoute]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1.GRPCRoute]) bool {
+		return routeChanged(e.ObjectOld, e.ObjectNew, e.ObjectOld.Status.Parents, e.ObjectNew.Status.Parents)
+	}}), nil
+}
+
+func getKindTLSRoute(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1alpha2.SchemeGroupVersion.WithKind(kindTLSRoute)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1alpha2.TLSRoute) []reconcile.Request {
+		return gatewayRouteRequests(ctx, r, kindTLSRoute)
+	})
+	return source.Kind(cache, &gwapiv1alpha2.TLSRoute{}, hdlr, predicate.TypedFuncs[*gwapiv1alpha2.TLSRoute]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1alpha2.TLSRoute]) bool {
+		return routeChanged(e.ObjectOld, e.ObjectNew, e.ObjectOld.Status.Parents, e.ObjectNew.Status.Parents)
+	}}), nil
+}
+
+func getKindTCPRoute(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1alpha2.SchemeGroupVersion.WithKind(kindTCPRoute)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1alpha2.TCPRoute) []reconcile.Request {
+		return gatewayRouteRequests(ctx, r, kindTCPRoute)
+	})
+	return source.Kind(cache, &gwapiv1alpha2.TCPRoute{}, hdlr, predicate.TypedFuncs[*gwapiv1alpha2.TCPRoute]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1alpha2.TCPRoute]) bool {
+		return routeChanged(e.ObjectOld, e.ObjectNew, e.ObjectOld.Status.Parents, e.ObjectNew.Status.Parents)
+	}}), nil
+}
+
+func getKindUDPRoute(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	if err := ensureServed(r, gwapiv1alpha2.SchemeGroupVersion.WithKind(kindUDPRoute)); err != nil {
+		return nil, err
+	}
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *gwapiv1alpha2.UDPRoute) []reconcile.Request {
+		return gatewayRouteRequests(ctx, r, kindUDPRoute)
+	})
+	return source.Kind(cache, &gwapiv1alpha2.UDPRoute{}, hdlr, predicate.TypedFuncs[*gwapiv1alpha2.UDPRoute]{UpdateFunc: func(e event.TypedUpdateEvent[*gwapiv1alpha2.UDPRoute]) bool {
+		return routeChanged(e.ObjectOld, e.ObjectNew, e.ObjectOld.Status.Parents, e.ObjectNew.Status.Parents)
+	}}), nil
+}
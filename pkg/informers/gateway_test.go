@@ -0,0 +1,57 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// restMapperClient only answers RESTMapper, which is all getKind needs before the CRD check fails
+type restMapperClient struct {
+	client.Client
+	mapper meta.RESTMapper
+}
+
+func (c restMapperClient) RESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
+func TestGatewayKindsWithoutCRDs(t *testing.T) {
+	// the RESTMapper knows none of the Gateway API kinds, as on a cluster without the CRDs
+	r := restMapperClient{mapper: meta.NewDefaultRESTMapper(nil)}
+
+	for _, gvk := range []schema.GroupVersionKind{
+		gwapiv1.SchemeGroupVersion.WithKind(kindGateway),
+		gwapiv1.SchemeGroupVersion.WithKind(kindHTTPRoute),
+		gwapiv1.SchemeGroupVersion.WithKind(kindGRPCRoute),
+		gwapiv1alpha2.SchemeGroupVersion.WithKind(kindTLSRoute),
+		gwapiv1alpha2.SchemeGroupVersion.WithKind(kindTCPRoute),
+		gwapiv1alpha2.SchemeGroupVersion.WithKind(kindUDPRoute),
+	} {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			if _, err := getKind(r, gvk, nil); !IsCRDNotInstalled(err) {
+				t.Fatalf("expected a CRDNotInstalledError for %s, got %v", gvk, err)
+			}
+		})
+	}
+}
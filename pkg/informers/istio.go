@@ -0,0 +1,79 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"context"
+	"maps"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	IstioNetworkingGroup = "networking.istio.io"
+
+	kindIstioGateway   = "Gateway"
+	kindVirtualService = "VirtualService"
+)
+
+// IsIstioKind reports whether t is one of the Istio kinds external-dns can read endpoints from.
+func IsIstioKind(t api.TypeInfo) bool {
+	return t.Group == IstioNetworkingGroup && (t.Kind == kindIstioGateway || t.Kind == kindVirtualService)
+}
+
+// The Istio types are watched through unstructured objects, so the operator does not need to
+// carry the Istio client types in its scheme and keeps working on clusters without Istio.
+func getKindIstio(cache cache.Cache, r client.Client, gvk schema.GroupVersionKind, match func(src api.SourceConfig) bool) (source.SyncingSource, error) {
+	if err := ensureServed(r, gvk); err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *unstructured.Unstructured) []reconcile.Request {
+		return ednsRequests(ctx, r, match)
+	})
+	return source.Kind(cache, obj, hdlr, predicate.TypedFuncs[*unstructured.Unstructured]{UpdateFunc: func(e event.TypedUpdateEvent[*unstructured.Unstructured]) bool {
+		// Reconcile only on spec changes (bumps Generation), annotations
+		// (hostname/ttl/etc.) or labels.
+		return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() ||
+			!maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations()) ||
+			!maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	}}), nil
+}
+
+func getKindIstioGateway(cache cache.Cache, r client.Client, gvk schema.GroupVersionKind) (source.SyncingSource, error) {
+	// virtual services take their targets from the gateways they are bound to
+	return getKindIstio(cache, r, gvk, func(src api.SourceConfig) bool { return IsIstioKind(src.Type) })
+}
+
+func getKindVirtualService(cache cache.Cache, r client.Client, gvk schema.GroupVersionKind) (source.SyncingSource, error) {
+	return getKindIstio(cache, r, gvk, func(src api.SourceConfig) bool {
+		return src.Type.Group == IstioNetworkingGroup && src.Type.Kind == kindVirtualService
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"slices"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
 
@@ -43,23 +44,28 @@ const (
 	kindIngress = "Ingress"
 )
 
-func getKindNode(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
-	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *corev1.Node) []reconcile.Request {
-		reconcileReq := make([]reconcile.Request, 0)
-		dnsList := &api.ExternalDNSList{}
+// ednsRequests returns a reconcile request for every ExternalDNS with a source accepted by match.
+func ednsRequests(ctx context.Context, r client.Client, match func(src api.SourceConfig) bool) []reconcile.Request {
+	reconcileReq := make([]reconcile.Request, 0)
+	dnsList := &api.ExternalDNSList{}
 
-		if err := r.List(ctx, dnsList); err != nil {
-			klog.Errorf("failed to list the external dns resources: %s", err.Error())
-			return reconcileReq
-		}
+	if err := r.List(ctx, dnsList); err != nil {
+		klog.Errorf("failed to list the external dns resources: %s", err.Error())
+		return reconcileReq
+	}
 
-		for _, edns := range dnsList.Items {
-			if edns.Spec.Source.Type.Kind == kindNode {
-				reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
-			}
+	for _, edns := range dnsList.Items {
+		if slices.ContainsFunc(edns.Spec.GetSources(), match) {
+			reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
 		}
+	}
 
-		return reconcileReq
+	return reconcileReq
+}
+
+func getKindNode(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
+	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *corev1.Node) []reconcile.Request {
+		return ednsRequests(ctx, r, func(src api.SourceConfig) bool { return src.Type.Kind == kindNode })
 	})
 	return source.Kind(cache, &corev1.Node{}, hdlr, predicate.TypedFuncs[*corev1.Node]{UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Node]) bool {
 		// Reconcile only when the addresses, labels, or annotations change —
@@ -73,21 +79,7 @@ func getKindNode(cache cache.Cache, r client.Client) (source.SyncingSource, erro
 
 func getKindService(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
 	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *corev1.Service) []reconcile.Request {
-		reconcileReq := make([]reconcile.Request, 0)
-		dnsList := &api.ExternalDNSList{}
-
-		if err := r.List(ctx, dnsList); err != nil {
-			klog.Errorf("failed to list the external dns resources: %s", err.Error())
-			return reconcileReq
-		}
-
-		for _, edns := range dnsList.Items {
-			if edns.Spec.Source.Type.Kind == kindService {
-				reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
-			}
-		}
-
-		return reconcileReq
+		return ednsRequests(ctx, r, func(src api.SourceConfig) bool { return src.Type.Kind == kindService })
 	})
 	return source.Kind(cache, &corev1.Service{}, hdlr, predicate.TypedFuncs[*corev1.Service]{UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Service]) bool {
 		// Reconcile only on changes that affect the endpoints external-dns
@@ -103,21 +95,7 @@ func getKindService(cache cache.Cache, r client.Client) (source.SyncingSource, e
 
 func getKindIngress(cache cache.Cache, r client.Client) (source.SyncingSource, error) {
 	hdlr := handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, a *networkingv1.Ingress) []reconcile.Request {
-		reconcileReq := make([]reconcile.Request, 0)
-		dnsList := &api.ExternalDNSList{}
-
-		if err := r.List(ctx, dnsList); err != nil {
-			klog.Errorf("failed to list the external dns resources: %s", err.Error())
-			return reconcileReq
-		}
-
-		for _, edns := range dnsList.Items {
-			if edns.Spec.Source.Type.Kind == kindIngress {
-				reconcileReq = append(reconcileReq, reconcile.Request{NamespacedName: client.ObjectKey{Name: edns.Name, Namespace: edns.Namespace}})
-			}
-		}
-
-		return reconcileReq
+		return ednsRequests(ctx, r, func(src api.SourceConfig) bool { return src.Type.Kind == kindIngress })
 	})
 
 	return source.Kind(cache, &networkingv1.Ingress{}, hdlr, predicate.TypedFuncs[*networkingv1.Ingress]{UpdateFunc: func(e event.TypedUpdateEvent[*networkingv1.Ingress]) bool {
@@ -132,6 +110,17 @@ func getKindIngress(cache cache.Cache, r client.Client) (source.SyncingSource, e
 }
 
 func getKind(r client.Client, gvk schema.GroupVersionKind, cache cache.Cache) (source.SyncingSource, error) {
+	// Istio reuses the Gateway kind, so its kinds are told apart by group
+	if gvk.Group == IstioNetworkingGroup {
+		switch gvk.Kind {
+		case kindIstioGateway:
+			return getKindIstioGateway(cache, r, gvk)
+		case kindVirtualService:
+			return getKindVirtualService(cache, r, gvk)
+		}
+		return nil, fmt.Errorf("unknown kind %v", gvk.Kind)
+	}
+
 	switch gvk.Kind {
 	case kindNode:
 		return getKindNode(cache, r)
@@ -139,6 +128,18 @@ func getKind(r client.Client, gvk schema.GroupVersionKind, cache cache.Cache) (s
 		return getKindService(cache, r)
 	case kindIngress:
 		return getKindIngress(cache, r)
+	case kindGateway:
+		return getKindGateway(cache, r)
+	case kindHTTPRoute:
+		return getKindHTTPRoute(cache, r)
+	case kindGRPCRoute:
+		return getKindGRPCRoute(cache, r)
+	case kindTLSRoute:
+		return getKindTLSRoute(cache, r)
+	case kindTCPRoute:
+		return getKindTCPRoute(cache, r)
+	case kindUDPRoute:
+		return getKindUDPRoute(cache, r)
 	}
 	return nil, fmt.Errorf("unknown kind %v", gvk.Kind)
 }
@@ -0,0 +1,264 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ns1 implements the NS1 provider. It follows the upstream ns1 provider, but takes the API key from the
+// caller instead of the environment and builds an HTTP client of its own, so every ExternalDNS calls NS1 with its
+// own key and skipping the TLS verification does not change http.DefaultClient.
+package ns1
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"strings"
+
+	api "gopkg.in/ns1/ns1-go.v2/rest"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+const (
+	ns1Create = "CREATE"
+	ns1Delete = "DELETE"
+	ns1Update = "UPDATE"
+
+	// defaultTTL is the TTL of the records that do not set one
+	defaultTTL = 10
+)
+
+// client is the subset of the NS1 API used by the provider
+type client interface {
+	CreateRecord(r *dns.Record) (*http.Response, error)
+	DeleteRecord(zone string, domain string, t string) (*http.Response, error)
+	UpdateRecord(r *dns.Record) (*http.Response, error)
+	GetZone(zone string) (*dns.Zone, *http.Response, error)
+	ListZones() ([]*dns.Zone, *http.Response, error)
+}
+
+type domainService struct {
+	service *api.Client
+}
+
+func (s domainService) CreateRecord(r *dns.Record) (*http.Response, error) {
+	return s.service.Records.Create(r)
+}
+
+func (s domainService) DeleteRecord(zone string, domain string, t string) (*http.Response, error) {
+	return s.service.Records.Delete(zone, domain, t)
+}
+
+func (s domainService) UpdateRecord(r *dns.Record) (*http.Response, error) {
+	return s.service.Records.Update(r)
+}
+
+func (s domainService) GetZone(zone string) (*dns.Zone, *http.Response, error) {
+	return s.service.Zones.Get(zone, true)
+}
+
+func (s domainService) ListZones() ([]*dns.Zone, *http.Response, error) {
+	return s.service.Zones.List()
+}
+
+// Config configures the NS1 provider
+type Config struct {
+	APIKey        string
+	Endpoint      string
+	IgnoreSSL     bool
+	DomainFilter  *endpoint.DomainFilter
+	ZoneIDFilter  provider.ZoneIDFilter
+	MinTTLSeconds int
+	DryRun        bool
+}
+
+// Provider manages the records of NS1 zones
+type Provider struct {
+	provider.BaseProvider
+	client        client
+	domainFilter  *endpoint.DomainFilter
+	zoneIDFilter  provider.ZoneIDFilter
+	dryRun        bool
+	minTTLSeconds int
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns an NS1 provider calling the API with the key of config
+func NewProvider(config Config) (*Provider, error) {
+	if config.APIKey == "" {
+		return nil, errors.New("no API key found for ns1 provider")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.IgnoreSSL {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // nolint:gosec
+	}
+	clientArgs := []func(*api.Client){api.SetAPIKey(config.APIKey)}
+	if config.Endpoint != "" {
+		clientArgs = append(clientArgs, api.SetEndpoint(config.Endpoint))
+	}
+
+	return newProvider(domainService{api.NewClient(&http.Client{Transport: transport}, clientArgs...)}, config), nil
+}
+
+func newProvider(c client, config Config) *Provider {
+	return &Provider{
+		client:        c,
+		domainFilter:  config.DomainFilter,
+		zoneIDFilter:  config.ZoneIDFilter,
+		dryRun:        config.DryRun,
+		minTTLSeconds: config.MinTTLSeconds,
+	}
+}
+
+// Records returns the records of the zones matching the filters
+func (p *Provider) Records(_ context.Context) ([]*endpoint.Endpoint, error) {
+	zones, err := p.zonesFiltered()
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []*endpoint.Endpoint
+	for _, zone := range zones {
+		zoneData, _, err := p.client.GetZone(zone.String())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range zoneData.Records {
+			if provider.SupportedRecordType(record.Type) {
+				endpoints = append(endpoints, endpoint.NewEndpointWithTTL(record.Domain, record.Type, endpoint.TTL(record.TTL), record.ShortAns...))
+			}
+		}
+	}
+	return endpoints, nil
+}
+
+// ApplyChanges creates, updates and deletes the records of changes
+func (p *Provider) ApplyChanges(_ context.Context, changes *plan.Changes) error {
+	combined := make([]*ns1Change, 0, len(changes.Create)+len(changes.UpdateNew)+len(changes.Delete))
+	combined = append(combined, newNS1Changes(ns1Create, changes.Create)...)
+	combined = append(combined, newNS1Changes(ns1Update, changes.UpdateNew)...)
+	combined = append(combined, newNS1Changes(ns1Delete, changes.Delete)...)
+
+	return p.submitChanges(combined)
+}
+
+// ns1Change is a record to create, update or delete
+type ns1Change struct {
+	Action   string
+	Endpoint *endpoint.Endpoint
+}
+
+func newNS1Changes(action string, endpoints []*endpoint.Endpoint) []*ns1Change {
+	changes := make([]*ns1Change, 0, len(endpoints))
+	for _, ep := range endpoints {
+		changes = append(changes, &ns1Change{Action: action, Endpoint: ep})
+	}
+	return changes
+}
+
+// buildRecord returns the NS1 record of a change
+func (p *Provider) buildRecord(zoneName string, change *ns1Change) *dns.Record {
+	record := dns.NewRecord(zoneName, change.Endpoint.DNSName, change.Endpoint.RecordType, map[string]string{}, []string{})
+	for _, v := range change.Endpoint.Targets {
+		record.AddAnswer(dns.NewAnswer(strings.Split(v, " ")))
+	}
+	// the default TTL respects the minimum TTL
+	ttl := max(defaultTTL, p.minTTLSeconds)
+	if change.Endpoint.RecordTTL.IsConfigured() {
+		ttl = int(change.Endpoint.RecordTTL)
+	}
+	record.TTL = ttl
+	return record
+}
+
+// submitChanges sends changes to NS1, zone by zone
+func (p *Provider) submitChanges(changes []*ns1Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	zones, err := p.zonesFiltered()
+	if err != nil {
+		return err
+	}
+
+	for zoneName, changes := range changesByZone(zones, changes) {
+		for _, change := range changes {
+			record := p.buildRecord(zoneName, change)
+			klog.InfoS("changing record", "record", record.Domain, "type", record.Type, "ttl", record.TTL, "action", change.Action, "zone", zoneName)
+			if p.dryRun {
+				continue
+			}
+
+			switch change.Action {
+			case ns1Create:
+				_, err = p.client.CreateRecord(record)
+			case ns1Delete:
+				_, err = p.client.DeleteRecord(zoneName, record.Domain, record.Type)
+			case ns1Update:
+				_, err = p.client.UpdateRecord(record)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// zonesFiltered returns the zones matching the domain and the zone id filters
+func (p *Provider) zonesFiltered() ([]*dns.Zone, error) {
+	zones, _, err := p.client.ListZones()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*dns.Zone
+	for _, z := range zones {
+		if p.domainFilter.Match(z.Zone) && p.zoneIDFilter.Match(z.ID) {
+			filtered = append(filtered, z)
+			klog.V(4).Infof("matched zone %s", z.Zone)
+		} else {
+			klog.V(4).Infof("filtered zone %s", z.Zone)
+		}
+	}
+	return filtered, nil
+}
+
+// changesByZone separates the changes by the zone of their record
+func changesByZone(zones []*dns.Zone, changeSets []*ns1Change) map[string][]*ns1Change {
+	changes := make(map[string][]*ns1Change)
+	zoneNameIDMapper := provider.ZoneIDName{}
+	for _, z := range zones {
+		zoneNameIDMapper.Add(z.Zone, z.Zone)
+		changes[z.Zone] = []*ns1Change{}
+	}
+
+	for _, c := range changeSets {
+		zone, _ := zoneNameIDMapper.FindZone(c.Endpoint.DNSName)
+		if zone == "" {
+			klog.V(4).Infof("skipping record %s because no hosted zone matches its DNS name", c.Endpoint.DNSName)
+			continue
+		}
+		changes[zone] = append(changes[zone], c)
+	}
+	return changes
+}
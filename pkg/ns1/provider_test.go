@@ -0,0 +1,91 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ns1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+func TestProviderWithAPIKey(t *testing.T) {
+	transport := http.DefaultClient.Transport
+
+	// every provider has its own key and API server, which rejects the key of the other
+	for _, key := range []string{"key-a", "key-b"} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-NSONE-Key") != key {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/v1/zones":
+				_, _ = fmt.Fprint(w, `[{"zone":"example.com","id":"example"}]`)
+			case "/v1/zones/example.com":
+				_, _ = fmt.Fprint(w, `{"zone":"example.com","records":[{"domain":"www.example.com","type":"A","ttl":300,"short_answers":["192.0.2.1"]}]}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		p, err := NewProvider(Config{APIKey: key, Endpoint: server.URL + "/v1/", IgnoreSSL: true, DomainFilter: endpoint.NewDomainFilter(nil)})
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		records, err := p.Records(context.Background())
+		if err != nil {
+			t.Fatalf("failed to list records: %v", err)
+		}
+		if len(records) != 1 || records[0].DNSName != "www.example.com" || records[0].RecordTTL != 300 {
+			t.Fatalf("unexpected records: %v", records)
+		}
+	}
+
+	if http.DefaultClient.Transport != transport {
+		t.Fatal("building the provider changed the transport of http.DefaultClient")
+	}
+	if _, err := NewProvider(Config{}); err == nil {
+		t.Fatal("expected an error without an API key")
+	}
+}
+
+func TestProviderDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("dry run provider called %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `[{"zone":"example.com","id":"example"}]`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(Config{APIKey: "key", Endpoint: server.URL + "/v1/", DomainFilter: endpoint.NewDomainFilter(nil), DryRun: true})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	changes := &plan.Changes{Create: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1")}}
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+}
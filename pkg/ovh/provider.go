@@ -0,0 +1,639 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ovh implements the OVH provider. It follows the upstream ovh provider, but takes the application key,
+// the application secret and the consumer key from the caller instead of the environment, so every ExternalDNS
+// calls OVH with its own keys.
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/patrickmn/go-cache"
+	"go.uber.org/ratelimit"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+const (
+	defaultTTL = 0
+
+	ovhCreate = iota
+	ovhDelete
+	ovhUpdate
+)
+
+// ErrRecordToMutateNotFound is returned when a record to update or delete is not found in its zone
+var ErrRecordToMutateNotFound = errors.New("record to mutate not found in current zone")
+
+// client is the subset of the OVH API client used by the provider
+type client interface {
+	PostWithContext(context.Context, string, any, any) error
+	PutWithContext(context.Context, string, any, any) error
+	GetWithContext(context.Context, string, any) error
+	DeleteWithContext(context.Context, string, any) error
+}
+
+// dnsClient queries the SOA of the zones, to find out whether the cached records are still valid
+type dnsClient interface {
+	ExchangeContext(ctx context.Context, m *dns.Msg, a string) (*dns.Msg, time.Duration, error)
+}
+
+// Config configures the OVH provider
+type Config struct {
+	// Endpoint is the OVH API endpoint, ex: ovh-eu
+	Endpoint string
+
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+
+	// APIRateLimit is the number of OVH API requests per second
+	APIRateLimit int
+
+	// EnableCNAMERelativeTarget sends the CNAME targets as they are, instead of fully qualified
+	EnableCNAMERelativeTarget bool
+
+	DomainFilter *endpoint.DomainFilter
+	DryRun       bool
+}
+
+// Provider manages the records of the OVH DNS zones. The records of a zone are cached until the serial of its SOA
+// changes.
+type Provider struct {
+	provider.BaseProvider
+
+	client         client
+	dnsClient      dnsClient
+	apiRateLimiter ratelimit.Limiter
+	domainFilter   *endpoint.DomainFilter
+	dryRun         bool
+
+	enableCNAMERelativeTarget bool
+
+	useCache      bool
+	cacheInstance *cache.Cache
+
+	// lastRunZones and lastRunRecords are listed by Records and used by the next ApplyChanges
+	lastRunZones   []string
+	lastRunRecords []record
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns an OVH provider calling the API with the keys of config
+func NewProvider(config Config) (*Provider, error) {
+	if config.ApplicationKey == "" || config.ApplicationSecret == "" || config.ConsumerKey == "" {
+		return nil, errors.New("no application key, application secret and consumer key found for ovh provider")
+	}
+
+	c, err := ovh.NewClient(config.Endpoint, config.ApplicationKey, config.ApplicationSecret, config.ConsumerKey)
+	if err != nil {
+		return nil, err
+	}
+	c.UserAgent = externaldns.UserAgent()
+	return newProvider(c, new(dns.Client), config), nil
+}
+
+func newProvider(c client, dc dnsClient, config Config) *Provider {
+	return &Provider{
+		client:                    c,
+		dnsClient:                 dc,
+		apiRateLimiter:            ratelimit.New(config.APIRateLimit),
+		domainFilter:              config.DomainFilter,
+		dryRun:                    config.DryRun,
+		enableCNAMERelativeTarget: config.EnableCNAMERelativeTarget,
+		useCache:                  true,
+		cacheInstance:             cache.New(cache.NoExpiration, cache.NoExpiration),
+	}
+}
+
+type recordFieldUpdate struct {
+	SubDomain string `json:"subDomain"`
+	TTL       int64  `json:"ttl"`
+	Target    string `json:"target"`
+}
+
+type recordFields struct {
+	recordFieldUpdate
+	FieldType string `json:"fieldType"`
+}
+
+// record is a record of an OVH zone
+type record struct {
+	recordFields
+	ID   uint64 `json:"id"`
+	Zone string `json:"zone"`
+}
+
+func (r record) String() string {
+	return "record#" + strconv.FormatUint(r.ID, 10) + ": " + r.FieldType + " | " + r.SubDomain + " => " + r.Target + " (" + strconv.FormatInt(r.TTL, 10) + ")"
+}
+
+// change is a record to create, update or delete
+type change struct {
+	record
+	Action int
+}
+
+func (c *change) String() string {
+	var action string
+	switch c.Action {
+	case ovhCreate:
+		action = "create"
+	case ovhUpdate:
+		action = "update"
+	case ovhDelete:
+		action = "delete"
+	default:
+		action = "unknown"
+	}
+
+	if c.ID != 0 {
+		return fmt.Sprintf("%s zone (ID : %d) action(%s) : %s %d IN %s %s", c.Zone, c.ID, action, c.SubDomain, c.TTL, c.FieldType, c.Target)
+	}
+	return fmt.Sprintf("%s zone action(%s) : %s %d IN %s %s", c.Zone, action, c.SubDomain, c.TTL, c.FieldType, c.Target)
+}
+
+// soa is the SOA of a zone, with the records cached for its serial
+type soa struct {
+	Server  string `json:"server"`
+	Serial  uint32 `json:"serial"`
+	records []record
+}
+
+// Records returns the records of the zones matching the domain filter
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	zones, records, err := p.zonesRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.lastRunRecords = records
+	p.lastRunZones = zones
+	endpoints := groupByNameAndType(records)
+	klog.V(4).Infof("found %d endpoints in %d ovh zones", len(endpoints), len(zones))
+	return endpoints, nil
+}
+
+// ApplyChanges applies changes to the zones and the records listed by the last Records call
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	zones, records := p.lastRunZones, p.lastRunRecords
+	defer func() {
+		p.lastRunRecords = []record{}
+		p.lastRunZones = []string{}
+	}()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for zoneName, changes := range changesByZoneName(zones, changes) {
+		eg.Go(func() error {
+			return p.handleSingleZoneUpdate(ctx, zoneName, records, changes)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return provider.NewSoftError(err)
+	}
+	return nil
+}
+
+// changesByZoneName separates changes by the zone of their records
+func changesByZoneName(zones []string, changes *plan.Changes) map[string]*plan.Changes {
+	zoneNameIDMapper := provider.ZoneIDName{}
+	for _, zone := range zones {
+		zoneNameIDMapper.Add(zone, zone)
+	}
+
+	output := map[string]*plan.Changes{}
+	zoneChanges := func(dnsName string) *plan.Changes {
+		_, zoneName := zoneNameIDMapper.FindZone(dnsName)
+		if _, ok := output[zoneName]; !ok {
+			output[zoneName] = &plan.Changes{}
+		}
+		return output[zoneName]
+	}
+	for _, ep := range changes.Delete {
+		c := zoneChanges(ep.DNSName)
+		c.Delete = append(c.Delete, ep)
+	}
+	for _, ep := range changes.Create {
+		c := zoneChanges(ep.DNSName)
+		c.Create = append(c.Create, ep)
+	}
+	for _, ep := range changes.UpdateOld {
+		c := zoneChanges(ep.DNSName)
+		c.UpdateOld = append(c.UpdateOld, ep)
+	}
+	for _, ep := range changes.UpdateNew {
+		c := zoneChanges(ep.DNSName)
+		c.UpdateNew = append(c.UpdateNew, ep)
+	}
+	return output
+}
+
+func (p *Provider) computeSingleZoneChanges(zoneName string, existingRecords []record, changes *plan.Changes) ([]change, error) {
+	var allChanges []change
+	var computed []change
+
+	computed, existingRecords = p.newChangeCreateDelete(ovhCreate, changes.Create, zoneName, existingRecords)
+	allChanges = append(allChanges, computed...)
+	computed, existingRecords = p.newChangeCreateDelete(ovhDelete, changes.Delete, zoneName, existingRecords)
+	allChanges = append(allChanges, computed...)
+
+	computed, err := p.newChangeUpdate(changes.UpdateOld, changes.UpdateNew, zoneName, existingRecords)
+	if err != nil {
+		return nil, err
+	}
+	return append(allChanges, computed...), nil
+}
+
+func (p *Provider) handleSingleZoneUpdate(ctx context.Context, zoneName string, existingRecords []record, changes *plan.Changes) error {
+	allChanges, err := p.computeSingleZoneChanges(zoneName, existingRecords, changes)
+	if err != nil {
+		return err
+	}
+	klog.Infof("applying %d changes to ovh zone %s", len(allChanges), zoneName)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, c := range allChanges {
+		eg.Go(func() error {
+			return p.change(egCtx, c)
+		})
+	}
+
+	// a zone with failed changes is not refreshed, some of its changes might not be applied yet. Its cache is
+	// invalidated so the next run lists its records again.
+	if err := eg.Wait(); err != nil {
+		p.invalidateCache(zoneName)
+		return err
+	}
+	return p.refresh(ctx, zoneName)
+}
+
+// refresh applies the changes of the zone and invalidates its cached records
+func (p *Provider) refresh(ctx context.Context, zone string) error {
+	p.invalidateCache(zone)
+
+	p.apiRateLimiter.Take()
+	if p.dryRun {
+		klog.Infof("dry run: would refresh ovh zone %s", zone)
+		return nil
+	}
+	if err := p.client.PostWithContext(ctx, fmt.Sprintf("/domain/zone/%s/refresh", url.PathEscape(zone)), nil, nil); err != nil {
+		return provider.NewSoftError(err)
+	}
+	return nil
+}
+
+func (p *Provider) change(ctx context.Context, c change) error {
+	p.apiRateLimiter.Take()
+
+	switch c.Action {
+	case ovhCreate:
+		klog.Infof("creating %s", c.String())
+		if p.dryRun {
+			return nil
+		}
+		return p.client.PostWithContext(ctx, fmt.Sprintf("/domain/zone/%s/record", url.PathEscape(c.Zone)), c.recordFields, nil)
+	case ovhDelete:
+		if c.ID == 0 {
+			return ErrRecordToMutateNotFound
+		}
+		klog.Infof("deleting %s", c.String())
+		if p.dryRun {
+			return nil
+		}
+		return p.client.DeleteWithContext(ctx, fmt.Sprintf("/domain/zone/%s/record/%d", url.PathEscape(c.Zone), c.ID), nil)
+	case ovhUpdate:
+		if c.ID == 0 {
+			return ErrRecordToMutateNotFound
+		}
+		klog.Infof("updating %s", c.String())
+		if p.dryRun {
+			return nil
+		}
+		return p.client.PutWithContext(ctx, fmt.Sprintf("/domain/zone/%s/record/%d", url.PathEscape(c.Zone), c.ID), c.recordFieldUpdate, nil)
+	default:
+		return nil
+	}
+}
+
+func (p *Provider) invalidateCache(zone string) {
+	p.cacheInstance.Delete(zone + "#soa")
+}
+
+// zonesRecords returns the zones matching the domain filter and their records
+func (p *Provider) zonesRecords(ctx context.Context) ([]string, []record, error) {
+	zones, err := p.zones(ctx)
+	if err != nil {
+		return nil, nil, provider.NewSoftError(err)
+	}
+
+	chRecords := make(chan []record, len(zones))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, zone := range zones {
+		eg.Go(func() error { return p.records(egCtx, zone, chRecords) })
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, provider.NewSoftError(err)
+	}
+	close(chRecords)
+
+	var allRecords []record
+	for records := range chRecords {
+		allRecords = append(allRecords, records...)
+	}
+	return zones, allRecords, nil
+}
+
+func (p *Provider) zones(ctx context.Context) ([]string, error) {
+	var zones []string
+	p.apiRateLimiter.Take()
+	if err := p.client.GetWithContext(ctx, "/domain/zone", &zones); err != nil {
+		return nil, err
+	}
+
+	var filtered []string
+	for _, zoneName := range zones {
+		if p.domainFilter == nil || p.domainFilter.Match(zoneName) {
+			filtered = append(filtered, zoneName)
+		}
+	}
+	return filtered, nil
+}
+
+// cachedRecords returns the cached records of zone, if the serial of its SOA did not change
+func (p *Provider) cachedRecords(ctx context.Context, zone string) ([]record, bool) {
+	cached, ok := p.cacheInstance.Get(zone + "#soa")
+	if !ok {
+		return nil, false
+	}
+	cachedSoa := cached.(soa)
+
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(zone), dns.TypeSOA)
+	in, _, err := p.dnsClient.ExchangeContext(ctx, m, strings.TrimSuffix(cachedSoa.Server, ".")+":53")
+	if err == nil && len(in.Answer) > 0 {
+		if s, ok := in.Answer[0].(*dns.SOA); ok && s.Serial == cachedSoa.Serial {
+			return cachedSoa.records, true
+		}
+	}
+
+	p.invalidateCache(zone)
+	return nil, false
+}
+
+// records sends the records of zone to records
+func (p *Provider) records(ctx context.Context, zone string, records chan<- []record) error {
+	if p.useCache {
+		if cached, ok := p.cachedRecords(ctx, zone); ok {
+			klog.V(4).Infof("using the cached records of ovh zone %s", zone)
+			records <- cached
+			return nil
+		}
+	}
+
+	p.apiRateLimiter.Take()
+	var zoneSoa soa
+	if p.useCache {
+		if err := p.client.GetWithContext(ctx, "/domain/zone/"+url.PathEscape(zone)+"/soa", &zoneSoa); err != nil {
+			return err
+		}
+	}
+
+	var recordIDs []uint64
+	if err := p.client.GetWithContext(ctx, fmt.Sprintf("/domain/zone/%s/record", url.PathEscape(zone)), &recordIDs); err != nil {
+		return err
+	}
+
+	chRecords := make(chan record, len(recordIDs))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, id := range recordIDs {
+		eg.Go(func() error { return p.record(egCtx, zone, id, chRecords) })
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	close(chRecords)
+
+	zoneRecords := make([]record, 0, len(recordIDs))
+	for r := range chRecords {
+		zoneRecords = append(zoneRecords, r)
+	}
+
+	if p.useCache {
+		zoneSoa.records = zoneRecords
+		_ = p.cacheInstance.Add(zone+"#soa", zoneSoa, cache.DefaultExpiration)
+	}
+	records <- zoneRecords
+	return nil
+}
+
+// record sends the record id of zone to records, if its type is supported
+func (p *Provider) record(ctx context.Context, zone string, id uint64, records chan<- record) error {
+	r := record{}
+	p.apiRateLimiter.Take()
+	if err := p.client.GetWithContext(ctx, fmt.Sprintf("/domain/zone/%s/record/%d", url.PathEscape(zone), id), &r); err != nil {
+		return err
+	}
+	if provider.SupportedRecordType(r.FieldType) {
+		records <- r
+	}
+	return nil
+}
+
+// groupByNameAndType returns an endpoint for the records of every name and type, with the TTL of the first record
+func groupByNameAndType(records []record) []*endpoint.Endpoint {
+	groups := map[string][]record{}
+	for _, r := range records {
+		key := r.Zone + "//" + r.SubDomain + "//" + r.FieldType
+		groups[key] = append(groups[key], r)
+	}
+
+	endpoints := make([]*endpoint.Endpoint, 0, len(groups))
+	for _, records := range groups {
+		targets := make([]string, 0, len(records))
+		for _, r := range records {
+			targets = append(targets, r.Target)
+		}
+		endpoints = append(endpoints, endpoint.NewEndpointWithTTL(
+			strings.TrimPrefix(records[0].SubDomain+"."+records[0].Zone, "."),
+			records[0].FieldType,
+			endpoint.TTL(records[0].TTL),
+			targets...,
+		))
+	}
+	return endpoints
+}
+
+// newChangeCreateDelete returns the changes creating or deleting the targets of endpoints, and the existing
+// records not deleted by them
+func (p *Provider) newChangeCreateDelete(action int, endpoints []*endpoint.Endpoint, zone string, existingRecords []record) ([]change, []record) {
+	var changes []change
+	var toDeleteIDs []int
+
+	for _, e := range endpoints {
+		for _, target := range e.Targets {
+			c := change{
+				Action: action,
+				record: record{
+					Zone: zone,
+					recordFields: recordFields{
+						FieldType: e.RecordType,
+						recordFieldUpdate: recordFieldUpdate{
+							SubDomain: convertDNSNameIntoSubDomain(e.DNSName, zone),
+							TTL:       defaultTTL,
+							Target:    target,
+						},
+					},
+				},
+			}
+			p.formatCNAMETarget(&c)
+			if e.RecordTTL.IsConfigured() {
+				c.TTL = int64(e.RecordTTL)
+			}
+
+			// the zone might have several records with the same target, each of them is deleted once
+			if action == ovhDelete {
+				for i, r := range existingRecords {
+					if r.Zone == c.Zone && r.SubDomain == c.SubDomain && r.FieldType == c.FieldType && r.Target == c.Target && !slices.Contains(toDeleteIDs, i) {
+						c.ID = r.ID
+						toDeleteIDs = append(toDeleteIDs, i)
+						break
+					}
+				}
+			}
+			changes = append(changes, c)
+		}
+	}
+
+	if len(toDeleteIDs) > 0 {
+		remaining := make([]record, 0, len(existingRecords)-len(toDeleteIDs))
+		for i := range existingRecords {
+			if !slices.Contains(toDeleteIDs, i) {
+				remaining = append(remaining, existingRecords[i])
+			}
+		}
+		existingRecords = remaining
+	}
+	return changes, existingRecords
+}
+
+func convertDNSNameIntoSubDomain(dnsName string, zoneName string) string {
+	if dnsName == zoneName {
+		return ""
+	}
+	return strings.TrimSuffix(dnsName, "."+zoneName)
+}
+
+func normalizeDNSName(dnsName string) string {
+	return strings.TrimSpace(strings.ToLower(dnsName))
+}
+
+// newChangeUpdate returns the changes turning the existing records of endpointsOld into endpointsNew. The records
+// of the old targets are updated in place where possible, the remaining targets are created or deleted.
+func (p *Provider) newChangeUpdate(endpointsOld []*endpoint.Endpoint, endpointsNew []*endpoint.Endpoint, zone string, existingRecords []record) ([]change, error) {
+	oldEndpoints := map[string]*endpoint.Endpoint{}
+	newEndpoints := map[string]*endpoint.Endpoint{}
+	oldRecordsInZone := map[string][]record{}
+
+	for _, e := range endpointsOld {
+		oldEndpoints[normalizeDNSName(e.RecordType+"//"+convertDNSNameIntoSubDomain(e.DNSName, zone))] = e
+	}
+	for _, e := range endpointsNew {
+		newEndpoints[normalizeDNSName(e.RecordType+"//"+convertDNSNameIntoSubDomain(e.DNSName, zone))] = e
+	}
+	for id := range oldEndpoints {
+		for _, r := range existingRecords {
+			if id == normalizeDNSName(r.FieldType+"//"+r.SubDomain) {
+				oldRecordsInZone[id] = append(oldRecordsInZone[id], r)
+			}
+		}
+	}
+
+	var changes []change
+	for id := range oldEndpoints {
+		oldRecords := slices.Clone(oldRecordsInZone[id])
+		newEndpoint, ok := newEndpoints[id]
+		if !ok {
+			return nil, errors.New("unrecoverable error: couldn't find the matching record in the update.New")
+		}
+
+		recordTTL := int64(defaultTTL)
+		if newEndpoint.RecordTTL.IsConfigured() {
+			recordTTL = int64(newEndpoint.RecordTTL)
+		}
+
+		// the targets kept are left as they are
+		var toInsert []string
+		for _, target := range newEndpoint.Targets {
+			i := slices.IndexFunc(oldRecords, func(r record) bool { return r.Target == target })
+			if i >= 0 {
+				oldRecords = slices.Delete(oldRecords, i, i+1)
+			} else {
+				toInsert = append(toInsert, target)
+			}
+		}
+
+		for _, target := range toInsert {
+			c := change{Action: ovhCreate}
+			if len(oldRecords) > 0 {
+				// the record of an old target is updated to the new one
+				c.Action = ovhUpdate
+				c.record = oldRecords[0]
+				oldRecords = slices.Delete(oldRecords, 0, 1)
+			} else {
+				c.record = record{
+					Zone: zone,
+					recordFields: recordFields{
+						FieldType: newEndpoint.RecordType,
+						recordFieldUpdate: recordFieldUpdate{
+							SubDomain: convertDNSNameIntoSubDomain(newEndpoint.DNSName, zone),
+						},
+					},
+				}
+			}
+			c.Target = target
+			c.TTL = recordTTL
+			p.formatCNAMETarget(&c)
+			changes = append(changes, c)
+		}
+
+		for _, r := range oldRecords {
+			changes = append(changes, change{Action: ovhDelete, record: r})
+		}
+	}
+	return changes, nil
+}
+
+// formatCNAMETarget makes the CNAME target of c fully qualified, unless relative targets are enabled
+func (p *Provider) formatCNAMETarget(c *change) {
+	if c.FieldType != endpoint.RecordTypeCNAME || p.enableCNAMERelativeTarget || strings.HasSuffix(c.Target, ".") {
+		return
+	}
+	c.Target += "."
+}
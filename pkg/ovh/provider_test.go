@@ -0,0 +1,169 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+// fakeClient serves the zone example.com and records the calls changing it
+type fakeClient struct {
+	mu    sync.Mutex
+	gets  int
+	calls []string
+}
+
+var responses = map[string]string{
+	"/domain/zone":                      `["example.com","example.org"]`,
+	"/domain/zone/example.com/soa":      `{"server":"ns.example.com.","serial":1}`,
+	"/domain/zone/example.com/record":   `[1,2,3]`,
+	"/domain/zone/example.com/record/1": `{"id":1,"zone":"example.com","fieldType":"A","subDomain":"www","target":"192.0.2.1","ttl":300}`,
+	"/domain/zone/example.com/record/2": `{"id":2,"zone":"example.com","fieldType":"A","subDomain":"www","target":"192.0.2.2","ttl":300}`,
+	"/domain/zone/example.com/record/3": `{"id":3,"zone":"example.com","fieldType":"MX","subDomain":"","target":"10 mail.example.com.","ttl":300}`,
+}
+
+func (c *fakeClient) GetWithContext(_ context.Context, url string, out any) error {
+	c.mu.Lock()
+	c.gets++
+	c.mu.Unlock()
+
+	resp, ok := responses[url]
+	if !ok {
+		return fmt.Errorf("unexpected request of %s", url)
+	}
+	return json.Unmarshal([]byte(resp), out)
+}
+
+func (c *fakeClient) call(method, url string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, method+" "+url)
+	return nil
+}
+
+func (c *fakeClient) PostWithContext(_ context.Context, url string, _, _ any) error {
+	return c.call("POST", url)
+}
+
+func (c *fakeClient) PutWithContext(_ context.Context, url string, _, _ any) error {
+	return c.call("PUT", url)
+}
+
+func (c *fakeClient) DeleteWithContext(_ context.Context, url string, _ any) error {
+	return c.call("DELETE", url)
+}
+
+// fakeDNSClient answers the SOA queries with serial
+type fakeDNSClient struct {
+	serial uint32
+}
+
+func (c *fakeDNSClient) ExchangeContext(_ context.Context, m *dns.Msg, _ string) (*dns.Msg, time.Duration, error) {
+	in := new(dns.Msg)
+	in.SetReply(m)
+	in.Answer = []dns.RR{&dns.SOA{Hdr: dns.RR_Header{Name: m.Question[0].Name, Rrtype: dns.TypeSOA}, Serial: c.serial}}
+	return in, 0, nil
+}
+
+func TestProviderRecords(t *testing.T) {
+	c, dc := &fakeClient{}, &fakeDNSClient{serial: 1}
+	p := newProvider(c, dc, Config{APIRateLimit: 100, DomainFilter: endpoint.NewDomainFilter([]string{"example.com"})})
+
+	records, err := p.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	// the A records of www share one endpoint, the MX record is skipped
+	if len(records) != 1 || records[0].DNSName != "www.example.com" || len(records[0].Targets) != 2 {
+		t.Fatalf("unexpected records: %v", records)
+	}
+
+	// the records are cached while the serial of the SOA does not change
+	gets := c.gets
+	if _, err := p.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if c.gets != gets+1 {
+		t.Fatalf("listed the records of an unchanged zone again, %d requests", c.gets-gets)
+	}
+	dc.serial = 2
+	if _, err := p.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if c.gets == gets+2 {
+		t.Fatal("used the cached records of a changed zone")
+	}
+}
+
+func TestProviderApplyChanges(t *testing.T) {
+	c := &fakeClient{}
+	p := newProvider(c, &fakeDNSClient{}, Config{APIRateLimit: 100, DomainFilter: endpoint.NewDomainFilter([]string{"example.com"})})
+	if _, err := p.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+
+	changes := &plan.Changes{
+		Create:    []*endpoint.Endpoint{endpoint.NewEndpoint("api.example.com", endpoint.RecordTypeCNAME, "www.example.com")},
+		UpdateOld: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1", "192.0.2.2")},
+		UpdateNew: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.1", "192.0.2.3")},
+	}
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+
+	sort.Strings(c.calls)
+	want := []string{
+		"POST /domain/zone/example.com/record",
+		"POST /domain/zone/example.com/refresh",
+		"PUT /domain/zone/example.com/record/2",
+	}
+	if fmt.Sprint(c.calls) != fmt.Sprint(want) {
+		t.Fatalf("calls = %v, want %v", c.calls, want)
+	}
+
+	// a dry run lists the records, but does not change them
+	c.calls = nil
+	p.dryRun = true
+	if _, err := p.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+	if len(c.calls) != 0 {
+		t.Fatalf("dry run provider called %v", c.calls)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	if _, err := NewProvider(Config{Endpoint: "ovh-eu", ApplicationKey: "key", ApplicationSecret: "secret", ConsumerKey: "consumer", APIRateLimit: 20}); err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	if _, err := NewProvider(Config{Endpoint: "ovh-eu", ApplicationKey: "key", APIRateLimit: 20}); err == nil {
+		t.Fatal("expected an error without the application secret and the consumer key")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"sync"
+
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/configdns-v2"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/provider/akamai"
+)
+
+// akamaiMu serializes the calls of the akamai providers. The edgegrid library keeps the credentials
+// of the last provider built in a package variable, which every API call reads.
+var akamaiMu sync.Mutex
+
+// akamaiProvider sets its own credentials in the edgegrid library before every API call
+type akamaiProvider struct {
+	provider.Provider
+	config edgegrid.Config
+}
+
+// newAkamaiProvider builds the akamai provider with the EdgeGrid tokens of the config, or else with the
+// .edgerc file of the credential. The file is not set in the config, since the upstream validation
+// rejects an .edgerc path without the tokens.
+func newAkamaiProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, zoneIDFilter provider.ZoneIDFilter, cred *credentials.Credential) (provider.Provider, error) {
+	edgercPath := cfg.AkamaiEdgercPath
+	if cred != nil && cred.FilePath != "" {
+		edgercPath = cred.FilePath
+	}
+
+	akamaiMu.Lock()
+	defer akamaiMu.Unlock()
+
+	p, err := akamai.NewAkamaiProvider(
+		akamai.AkamaiConfig{
+			DomainFilter:          domainFilter,
+			ZoneIDFilter:          zoneIDFilter,
+			ServiceConsumerDomain: cfg.AkamaiServiceConsumerDomain,
+			ClientToken:           cfg.AkamaiClientToken,
+			ClientSecret:          cfg.AkamaiClientSecret,
+			AccessToken:           cfg.AkamaiAccessToken,
+			EdgercPath:            edgercPath,
+			EdgercSection:         cfg.AkamaiEdgercSection,
+			DryRun:                cfg.DryRun,
+		}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &akamaiProvider{Provider: p, config: dns.Config}, nil
+}
+
+func (p *akamaiProvider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	akamaiMu.Lock()
+	defer akamaiMu.Unlock()
+
+	dns.Init(p.config)
+	return p.Provider.Records(ctx)
+}
+
+func (p *akamaiProvider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	akamaiMu.Lock()
+	defer akamaiMu.Unlock()
+
+	dns.Init(p.config)
+	return p.Provider.ApplyChanges(ctx, changes)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	edgegridclient "github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns/validation"
+)
+
+var clientTokenRegexp = regexp.MustCompile(`client_token=([^;]+);`)
+
+func TestAkamaiProvidersKeepTheirCredentials(t *testing.T) {
+	var lastToken string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m := clientTokenRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			lastToken = m[1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"zones":[]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := edgegridclient.Client
+	edgegridclient.Client = server.Client()
+	t.Cleanup(func() { edgegridclient.Client = client })
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderAkamai,
+			Source:   api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+		},
+	}
+
+	// the first provider is built with the EdgeGrid tokens
+	tokenCfg := convertEDNSObjectToCfg(edns)
+	tokenCred := &credentials.Credential{Akamai: &credentials.AkamaiCredential{
+		ServiceConsumerDomain: server.URL,
+		ClientToken:           "token-client",
+		ClientSecret:          "token-secret",
+		AccessToken:           "token-access",
+	}}
+	applyCredential(tokenCfg, tokenCred)
+	if err := validation.ValidateConfig(tokenCfg); err != nil {
+		t.Fatalf("config validation failed: %v", err)
+	}
+	tokenProvider, err := buildProvider(context.Background(), tokenCfg, createDomainFilter(tokenCfg), tokenCred)
+	if err != nil {
+		t.Fatalf("failed to build provider with tokens: %v", err)
+	}
+
+	// the second one with an .edgerc file
+	edgerc := filepath.Join(t.TempDir(), "edgerc")
+	data := fmt.Sprintf("[default]\nhost = %s\nclient_token = edgerc-client\nclient_secret = edgerc-secret\naccess_token = edgerc-access\n", server.URL)
+	if err := os.WriteFile(edgerc, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	edgercCfg := convertEDNSObjectToCfg(edns)
+	edgercCred := &credentials.Credential{FilePath: edgerc}
+	applyCredential(edgercCfg, edgercCred)
+	if err := validation.ValidateConfig(edgercCfg); err != nil {
+		t.Fatalf("config validation failed: %v", err)
+	}
+	edgercProvider, err := buildProvider(context.Background(), edgercCfg, createDomainFilter(edgercCfg), edgercCred)
+	if err != nil {
+		t.Fatalf("failed to build provider with .edgerc file: %v", err)
+	}
+
+	// the provider built last must not make the first one call the API with its credentials
+	if _, err := tokenProvider.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if lastToken != "token-client" {
+		t.Fatalf("provider built with tokens called the API as %q", lastToken)
+	}
+	if _, err := edgercProvider.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if lastToken != "edgerc-client" {
+		t.Fatalf("provider built with .edgerc file called the API as %q", lastToken)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/provider/awssd"
+)
+
+// unwrapProvider returns the provider behind the provider cache, if any
+func unwrapProvider(p provider.Provider) provider.Provider {
+	if cached, ok := p.(*provider.CachedProvider); ok {
+		return cached.Provider
+	}
+	return p
+}
+
+// setCloudMapIDs sets the Cloud Map namespace and service ids of the records. A record name is the
+// name of its Cloud Map service followed by the name of the namespace of the service.
+func setCloudMapIDs(ctx context.Context, p *awssd.AWSSDProvider, records []api.DNSRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	namespaces, err := p.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]*api.CloudMapRecord)
+	for _, ns := range namespaces {
+		services, err := p.ListServicesByNamespaceID(ctx, ns.Id)
+		if err != nil {
+			return err
+		}
+		for _, srv := range services {
+			if srv.Name == nil || srv.Id == nil {
+				continue
+			}
+			ids[strings.ToLower(*srv.Name+"."+*ns.Name)] = &api.CloudMapRecord{
+				NamespaceID: *ns.Id,
+				ServiceID:   *srv.Id,
+			}
+		}
+	}
+
+	for i := range records {
+		records[i].CloudMap = ids[strings.ToLower(strings.TrimSuffix(records[i].Name, "."))]
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	sd "github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	sdtypes "github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/provider/awssd"
+)
+
+// fakeSDClient serves a single Cloud Map namespace with a single service
+type fakeSDClient struct {
+	awssd.AWSSDClient
+}
+
+func (fakeSDClient) ListNamespaces(context.Context, *sd.ListNamespacesInput, ...func(*sd.Options)) (*sd.ListNamespacesOutput, error) {
+	return &sd.ListNamespacesOutput{
+		Namespaces: []sdtypes.NamespaceSummary{{Id: ptr.To("ns-1"), Name: ptr.To("example.com")}},
+	}, nil
+}
+
+func (fakeSDClient) ListServices(_ context.Context, in *sd.ListServicesInput, _ ...func(*sd.Options)) (*sd.ListServicesOutput, error) {
+	if in.Filters[0].Values[0] != "ns-1" {
+		return &sd.ListServicesOutput{}, nil
+	}
+	return &sd.ListServicesOutput{
+		Services: []sdtypes.ServiceSummary{{Id: ptr.To("srv-1"), Name: ptr.To("www")}},
+	}, nil
+}
+
+func TestSetCloudMapIDs(t *testing.T) {
+	p, err := awssd.NewAWSSDProvider(&endpoint.DomainFilter{}, "", false, false, "default", nil, fakeSDClient{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := []api.DNSRecord{
+		{Name: "www.example.com", Target: "192.0.2.10"},
+		{Name: "api.example.com", Target: "192.0.2.11"},
+	}
+	if err := setCloudMapIDs(context.Background(), p, records); err != nil {
+		t.Fatalf("failed to set Cloud Map ids: %v", err)
+	}
+
+	if got := records[0].CloudMap; got == nil || got.NamespaceID != "ns-1" || got.ServiceID != "srv-1" {
+		t.Fatalf("www.example.com has Cloud Map ids %v", got)
+	}
+	if got := records[1].CloudMap; got != nil {
+		t.Fatalf("api.example.com has Cloud Map ids %v, but no service", got)
+	}
+}
+
+func TestAWSSDRegistry(t *testing.T) {
+	tests := []struct {
+		registry *api.Registry
+		want     string
+	}{
+		{registry: nil, want: "aws-sd"},
+		{registry: &api.Registry{Type: api.RegistryTXT}, want: "aws-sd"},
+		{registry: &api.Registry{Type: api.RegistryNoop}, want: "noop"},
+		{registry: &api.Registry{Type: api.RegistryAWSSD}, want: "aws-sd"},
+		{registry: &api.Registry{Type: api.RegistryDynamoDB}, want: "dynamodb"},
+	}
+
+	for _, tc := range tests {
+		edns := &api.ExternalDNS{
+			Spec: api.ExternalDNSSpec{
+				Provider: api.ProviderAWSSD,
+				Registry: tc.registry,
+			},
+		}
+		if got := convertEDNSObjectToCfg(edns).Registry; got != tc.want {
+			t.Errorf("registry %v: got %s, want %s", tc.registry, got, tc.want)
+		}
+	}
+}
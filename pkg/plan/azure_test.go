@@ -0,0 +1,59 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestAzurePrivateZones(t *testing.T) {
+	const authorityHost = "https://login.chinacloudapi.cn/"
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderAzure,
+			Azure: &api.AzureProvider{
+				ZoneType:                     ptr.To(api.AzureZoneTypePrivate),
+				ActiveDirectoryAuthorityHost: ptr.To(authorityHost),
+			},
+		},
+	}
+
+	cfg := convertEDNSObjectToCfg(edns)
+	if cfg.Provider != "azure-private-dns" {
+		t.Fatalf("provider = %s, want azure-private-dns", cfg.Provider)
+	}
+	if cfg.AzureActiveDirectoryAuthorityHost != authorityHost {
+		t.Fatalf("authority host = %s, want %s", cfg.AzureActiveDirectoryAuthorityHost, authorityHost)
+	}
+
+	// the config file is never read without the resource group and the subscription
+	if _, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), &credentials.Credential{}); err == nil {
+		t.Fatal("expected private zones without resourceGroup and subscriptionId to fail")
+	}
+
+	edns.Spec.Azure.ZoneType = ptr.To(api.AzureZoneTypePublic)
+	if cfg := convertEDNSObjectToCfg(edns); cfg.Provider != "azure" {
+		t.Fatalf("provider = %s, want azure", cfg.Provider)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/registry"
+	"sigs.k8s.io/external-dns/source"
+)
+
+// components are the source, provider and registry of an ExternalDNS. They are reused by its syncs, so the
+// informers of the source and the zone caches of the provider outlive a single reconcile, until the effective
+// config or the provider secret changes. A component failing to build is not cached and is built again by
+// the next sync.
+type components struct {
+	hash string
+
+	// ctx lives as long as the components, the informers of the source and the clients of the provider run in it
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	source   source.Source
+	provider provider.Provider
+	registry registry.Registry
+}
+
+var (
+	// cachedComponents keeps the components of every ExternalDNS. The reconciles of one ExternalDNS are
+	// serialized, so its components are never built concurrently.
+	cachedComponents = map[types.NamespacedName]*components{}
+	componentsMu     sync.Mutex
+)
+
+// getComponents returns the components of the ExternalDNS built with cfg and cred. The cached components are
+// dropped, and their informers stopped, when they were built with a different config or secret.
+func getComponents(edns *api.ExternalDNS, cfg *externaldns.Config, cred *credentials.Credential) *components {
+	key := types.NamespacedName{Namespace: edns.Namespace, Name: edns.Name}
+	hash := componentsHash(edns, cfg, cred)
+
+	componentsMu.Lock()
+	defer componentsMu.Unlock()
+
+	if c, found := cachedComponents[key]; found {
+		if c.hash == hash {
+			return c
+		}
+		klog.InfoS("config or provider secret changed, building the source, provider and registry again", "externaldns", key)
+		c.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &components{hash: hash, ctx: ctx, cancel: cancel}
+	cachedComponents[key] = c
+	return c
+}
+
+// ForgetComponents drops the cached source, provider and registry of the ExternalDNS and stops the informers of its source
+func ForgetComponents(edns *api.ExternalDNS) {
+	componentsMu.Lock()
+	defer componentsMu.Unlock()
+
+	key := types.NamespacedName{Namespace: edns.Namespace, Name: edns.Name}
+	if c, found := cachedComponents[key]; found {
+		c.cancel()
+		delete(cachedComponents, key)
+	}
+}
+
+// componentsConfig returns the config the components are built with. The dry run mode and the sync interval are
+// left to the plan, which never applies changes in dry run mode, so changing them keeps the components.
+func componentsConfig(cfg *externaldns.Config) *externaldns.Config {
+	built := *cfg
+	built.DryRun = false
+	built.Interval = 0
+	return &built
+}
+
+// componentsHash returns the content hash of everything the components are built from. The secret material of
+// the config is left out, the credential is identified by the resource versions of its secrets instead.
+func componentsHash(edns *api.ExternalDNS, cfg *externaldns.Config, cred *credentials.Credential) string {
+	var secretVersion string
+	if cred != nil {
+		secretVersion = cred.SecretVersion
+	}
+
+	hashed := componentsConfig(cfg)
+	hashed.RFC2136TSIGSecret, hashed.RFC2136KerberosPassword = "", ""
+	hashed.AkamaiClientToken, hashed.AkamaiClientSecret, hashed.AkamaiAccessToken = "", "", ""
+	hashed.TXTEncryptAESKey = ""
+	hashed.PDNSAPIKey = ""
+	hashed.GoDaddyAPIKey, hashed.GoDaddySecretKey = "", ""
+	hashed.PiholePassword = ""
+	hashed.ExoscaleAPIKey, hashed.ExoscaleAPISecret = "", ""
+
+	data, _ := json.Marshal(struct { // nolint:errchkjson
+		Config *externaldns.Config
+		// the regular expressions are not marshaled by their fields
+		RegexDomainFilter    string
+		RegexDomainExclusion string
+		Sources              []api.SourceConfig
+		DynamoDB             *api.DynamoDBRegistry
+		SecretVersion        string
+	}{
+		Config:               hashed,
+		RegexDomainFilter:    regexpString(cfg.RegexDomainFilter),
+		RegexDomainExclusion: regexpString(cfg.RegexDomainExclusion),
+		Sources:              edns.Spec.GetSources(),
+		DynamoDB:             dynamoDBSpec(edns),
+		SecretVersion:        secretVersion,
+	})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func regexpString(r *regexp.Regexp) string {
+	if r == nil {
+		return ""
+	}
+	return r.String()
+}
+
+func (c *components) getSource(cfg *externaldns.Config, srcs []api.SourceConfig) (source.Source, error) {
+	if c.source == nil {
+		s, err := createEndpointsSource(c.ctx, componentsConfig(cfg), srcs)
+		if err != nil {
+			return nil, err
+		}
+		c.source = s
+	}
+	return c.source, nil
+}
+
+func (c *components) getProvider(
+	edns *api.ExternalDNS,
+	cfg *externaldns.Config,
+	domainFilter *endpoint.DomainFilter,
+	cred *credentials.Credential,
+) (provider.Provider, error) {
+	if c.provider == nil {
+		p, err := newProvider(c.ctx, edns, componentsConfig(cfg), domainFilter, cred)
+		if err != nil {
+			return nil, err
+		}
+		c.provider = p
+	}
+	return c.provider, nil
+}
+
+func (c *components) getRegistry(
+	edns *api.ExternalDNS,
+	cfg *externaldns.Config,
+	p provider.Provider,
+	cred *credentials.Credential,
+) (registry.Registry, error) {
+	if c.registry == nil {
+		r, err := createRegistry(c.ctx, edns, componentsConfig(cfg), p, cred)
+		if err != nil {
+			return nil, err
+		}
+		c.registry = r
+	}
+	return c.registry, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"testing"
+	"time"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/registry"
+)
+
+func TestComponentsCachedUntilConfigOrSecretChanges(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "cache"},
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+		},
+	}
+	t.Cleanup(func() {
+		ForgetComponents(edns)
+		ForgetInMemoryRecords(edns)
+	})
+
+	components := func(cred *credentials.Credential) (*components, registry.Registry) {
+		t.Helper()
+		cfg := convertEDNSObjectToCfg(edns)
+		c := getComponents(edns, cfg, cred)
+		pvdr, err := c.getProvider(edns, cfg, createDomainFilter(cfg), cred)
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		reg, err := c.getRegistry(edns, cfg, pvdr, cred)
+		if err != nil {
+			t.Fatalf("failed to create registry: %v", err)
+		}
+		return c, reg
+	}
+
+	first, reg := components(&credentials.Credential{SecretVersion: "credential=1"})
+	if c, r := components(&credentials.Credential{SecretVersion: "credential=1"}); c != first || r != reg {
+		t.Fatal("the components are built again for an unchanged config")
+	}
+
+	// the credential is identified by the version of its secrets, the secret material of the config is not hashed
+	withSecret := convertEDNSObjectToCfg(edns)
+	withSecret.PDNSAPIKey = "key"
+	if componentsHash(edns, withSecret, &credentials.Credential{SecretVersion: "credential=1"}) !=
+		componentsHash(edns, convertEDNSObjectToCfg(edns), &credentials.Credential{SecretVersion: "credential=1"}) {
+		t.Fatal("the secret material is part of the components hash")
+	}
+
+	// the settings left to the plan keep the components
+	edns.Spec.DryRun = ptr.To(true)
+	edns.Spec.Interval = &metav1.Duration{Duration: time.Hour}
+	edns.Spec.Approval = ptr.To(api.ApprovalManual)
+	edns.Spec.DeletionPolicy = ptr.To(api.DeletionPolicyRetain)
+	if c, r := components(&credentials.Credential{SecretVersion: "credential=1"}); c != first || r != reg {
+		t.Fatal("the components are built again after a change of the settings of the plan")
+	}
+
+	// a new version of the provider secret builds the components again
+	second, r := components(&credentials.Credential{SecretVersion: "credential=2"})
+	if second == first || r == reg {
+		t.Fatal("the components are reused after the secret changed")
+	}
+	if first.ctx.Err() == nil {
+		t.Fatal("the context of the replaced components is not canceled")
+	}
+
+	// so does a change of the spec
+	edns.Spec.TXTOwnerID = ptr.To("other")
+	third, _ := components(&credentials.Credential{SecretVersion: "credential=2"})
+	if third == second {
+		t.Fatal("the components are reused after the spec changed")
+	}
+
+	ForgetComponents(edns)
+	if third.ctx.Err() == nil {
+		t.Fatal("the context of the forgotten components is not canceled")
+	}
+	if fourth, _ := components(&credentials.Credential{SecretVersion: "credential=2"}); fourth == third {
+		t.Fatal("the forgotten components are reused")
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+)
+
+func TestCoreDNSProviderCredential(t *testing.T) {
+	// the etcd settings of the operator are never read
+	t.Setenv("ETCD_URLS", "ftp://operator-etcd:2379")
+	t.Setenv("ETCD_CA_FILE", filepath.Join(t.TempDir(), "operator-ca.crt"))
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderCoreDNS,
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+
+	tests := []struct {
+		name    string
+		cred    *credentials.Credential
+		wantErr bool
+	}{
+		{
+			name: "default endpoint",
+			cred: &credentials.Credential{Etcd: &credentials.EtcdCredential{}},
+		},
+		{
+			name: "plain http endpoints",
+			cred: &credentials.Credential{Etcd: &credentials.EtcdCredential{URLs: []string{"http://127.0.0.1:2379"}}},
+		},
+		{
+			name: "tls endpoints without certificates",
+			cred: &credentials.Credential{Etcd: &credentials.EtcdCredential{URLs: []string{"https://127.0.0.1:2379"}, TLSServerName: "etcd"}},
+		},
+		{
+			// the CA file cannot be read, so the TLS configuration was built from the credential
+			name: "tls endpoints read the CA file of the credential",
+			cred: &credentials.Credential{
+				Etcd: &credentials.EtcdCredential{URLs: []string{"https://127.0.0.1:2379"}},
+				TLS:  &credentials.TLSCredential{CAFilePath: filepath.Join(t.TempDir(), "missing-ca.crt")},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			cred:    &credentials.Credential{Etcd: &credentials.EtcdCredential{URLs: []string{"ftp://127.0.0.1:2379"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *cfg
+			applyCredential(&cfg, tc.cred)
+			_, err := buildProvider(context.Background(), &cfg, createDomainFilter(&cfg), tc.cred)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("buildProvider error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"kubeops.dev/external-dns-operator/pkg/cloudflare"
+	"kubeops.dev/external-dns-operator/pkg/coredns"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+	"kubeops.dev/external-dns-operator/pkg/google"
+	"kubeops.dev/external-dns-operator/pkg/ovh"
+	"kubeops.dev/external-dns-operator/pkg/webhook"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/pkg/tlsutils"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/provider/aws"
+)
+
+// applyCredential sets the secret material of providers that take it from the config, so the
+// config is validated with it
+func applyCredential(cfg *externaldns.Config, cred *credentials.Credential) {
+	if cred == nil {
+		return
+	}
+	if cred.TLS != nil {
+		cfg.TLSCA = cred.TLS.CAFilePath
+		cfg.TLSClientCert = cred.TLS.ClientCertFilePath
+		cfg.TLSClientCertKey = cred.TLS.ClientKeyFilePath
+	}
+	if cred.RFC2136 != nil {
+		cfg.RFC2136TSIGSecret = cred.RFC2136.TSIGSecret
+		cfg.RFC2136KerberosUsername = cred.RFC2136.KerberosUsername
+		cfg.RFC2136KerberosPassword = cred.RFC2136.KerberosPassword
+	}
+
+	if cred.Akamai != nil {
+		cfg.AkamaiServiceConsumerDomain = cred.Akamai.ServiceConsumerDomain
+		cfg.AkamaiClientToken = cred.Akamai.ClientToken
+		cfg.AkamaiClientSecret = cred.Akamai.ClientSecret
+		cfg.AkamaiAccessToken = cred.Akamai.AccessToken
+	}
+	if cred.TXTEncryption != nil {
+		cfg.TXTEncryptEnabled = true
+		cfg.TXTEncryptAESKey = string(cred.TXTEncryption.AESKey)
+	}
+	switch cfg.Provider {
+	case "pdns":
+		cfg.PDNSAPIKey = cred.APIKey
+	case "godaddy":
+		cfg.GoDaddyAPIKey = cred.APIKey
+		cfg.GoDaddySecretKey = cred.APISecret
+	case "pihole":
+		cfg.PiholePassword = cred.Password
+	case "exoscale":
+		cfg.ExoscaleAPIKey = cred.APIKey
+		cfg.ExoscaleAPISecret = cred.APISecret
+	case "transip":
+		cfg.TransIPPrivateKeyFile = cred.FilePath
+	case "alibabacloud":
+		// an empty config file makes the provider use the RAM role of the ECS instance
+		cfg.AlibabaCloudConfigFile = cred.FilePath
+	}
+}
+
+// newCoreDNSProvider connects the coredns provider to the etcd cluster of the credential
+func newCoreDNSProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	etcd := &credentials.EtcdCredential{}
+	if cred != nil && cred.Etcd != nil {
+		etcd = cred.Etcd
+	}
+
+	var tlsConfig *tls.Config
+	if len(etcd.URLs) > 0 && strings.HasPrefix(strings.ToLower(etcd.URLs[0]), "https://") {
+		var err error
+		tlsConfig, err = tlsutils.NewTLSConfig(cfg.TLSClientCert, cfg.TLSClientCertKey, cfg.TLSCA, etcd.TLSServerName, etcd.TLSInsecure, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := coredns.NewConfig(etcd.URLs, etcd.Username, etcd.Password, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return coredns.NewProvider(*config, domainFilter, cfg.CoreDNSPrefix, cfg.DryRun)
+}
+
+// newWebhookProvider calls the webhook provider with the bearer token and TLS certificates of the credential
+func newWebhookProvider(ctx context.Context, cfg *externaldns.Config, cred *credentials.Credential) (provider.Provider, error) {
+	var token string
+	if cred != nil {
+		token = cred.BearerToken
+	}
+
+	var tlsConfig *tls.Config
+	if cfg.TLSCA != "" || cfg.TLSClientCert != "" || cfg.TLSClientCertKey != "" {
+		var err error
+		tlsConfig, err = tlsutils.NewTLSConfig(cfg.TLSClientCert, cfg.TLSClientCertKey, cfg.TLSCA, "", false, tls.VersionTLS12)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := webhook.NewClient(token, tlsConfig)
+	return webhook.NewProvider(ctx, cfg.WebhookProviderURL, client, cfg.WebhookProviderReadTimeout, cfg.WebhookProviderWriteTimeout)
+}
+
+// newCloudflareProvider calls the cloudflare provider with the API token, or the API key and email, of the credential
+func newCloudflareProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, zoneIDFilter provider.ZoneIDFilter, cred *credentials.Credential) (provider.Provider, error) {
+	cf := &credentials.CloudflareCredential{}
+	if cred != nil && cred.Cloudflare != nil {
+		cf = cred.Cloudflare
+	}
+	return cloudflare.NewProvider(cloudflare.Config{
+		APIToken:         cf.APIToken,
+		APIKey:           cf.APIKey,
+		APIEmail:         cf.APIEmail,
+		BaseURL:          cf.BaseURL,
+		ProxiedByDefault: cfg.CloudflareProxied,
+		RegionalServicesConfig: cloudflare.RegionalServicesConfig{
+			Enabled:   cfg.CloudflareRegionalServices,
+			RegionKey: cfg.CloudflareRegionKey,
+		},
+		CustomHostnamesConfig: cloudflare.CustomHostnamesConfig{
+			Enabled:              cfg.CloudflareCustomHostnames,
+			MinTLSVersion:        cfg.CloudflareCustomHostnamesMinTLSVersion,
+			CertificateAuthority: cfg.CloudflareCustomHostnamesCertificateAuthority,
+		},
+		DNSRecordsConfig: cloudflare.DNSRecordsConfig{
+			PerPage: cfg.CloudflareDNSRecordsPerPage,
+			Comment: cfg.CloudflareDNSRecordsComment,
+		},
+		DomainFilter: domainFilter,
+		ZoneIDFilter: zoneIDFilter,
+		DryRun:       cfg.DryRun,
+	})
+}
+
+// newGoogleProvider calls the google provider with the service account key of the credential. Without a key,
+// it uses the workload identity of the operator.
+func newGoogleProvider(ctx context.Context, cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, zoneIDFilter provider.ZoneIDFilter, cred *credentials.Credential) (provider.Provider, error) {
+	var keyFile string
+	if cred != nil {
+		keyFile = cred.FilePath
+	}
+	return google.NewProvider(ctx, google.Config{
+		Project:             cfg.GoogleProject,
+		CredentialsFile:     keyFile,
+		BatchChangeSize:     cfg.GoogleBatchChangeSize,
+		BatchChangeInterval: cfg.GoogleBatchChangeInterval,
+		ZoneVisibility:      cfg.GoogleZoneVisibility,
+		DomainFilter:        domainFilter,
+		ZoneIDFilter:        zoneIDFilter,
+		DryRun:              cfg.DryRun,
+	})
+}
+
+// newOVHProvider calls the ovh provider with the application and consumer keys of the credential
+func newOVHProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	keys := &credentials.OVHCredential{}
+	if cred != nil && cred.OVH != nil {
+		keys = cred.OVH
+	}
+	return ovh.NewProvider(ovh.Config{
+		Endpoint:                  cfg.OVHEndpoint,
+		ApplicationKey:            keys.ApplicationKey,
+		ApplicationSecret:         keys.ApplicationSecret,
+		ConsumerKey:               keys.ConsumerKey,
+		APIRateLimit:              cfg.OVHApiRateLimit,
+		EnableCNAMERelativeTarget: cfg.OVHEnableCNAMERelative,
+		DomainFilter:              domainFilter,
+		DryRun:                    cfg.DryRun,
+	})
+}
+
+// createAWSConfig mirrors aws.CreateDefaultV2Config, but reads the shared credentials from the
+// credential file of the ExternalDNS and returns errors instead of exiting the process.
+func createAWSConfig(ctx context.Context, cfg *externaldns.Config, cred *credentials.Credential) (awsv2.Config, error) {
+	opts := []func(*awsconfig.LoadOptions) error{
+		awsconfig.WithRetryer(func() awsv2.Retryer {
+			return retry.AddWithMaxAttempts(retry.NewStandard(), cfg.AWSAPIRetries)
+		}),
+		awsconfig.WithAPIOptions(aws.GetInstrumentationMiddlewares()),
+	}
+	// without a secret, the credentials come from IRSA or the environment of the operator
+	if cred != nil && cred.FilePath != "" {
+		opts = append(opts, awsconfig.WithSharedCredentialsFiles([]string{cred.FilePath}))
+	}
+
+	config, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return awsv2.Config{}, fmt.Errorf("instantiating AWS config: %w", err)
+	}
+
+	if cfg.AWSAssumeRole != "" {
+		var assumeRoleOpts []func(*stscreds.AssumeRoleOptions)
+		if cfg.AWSAssumeRoleExternalID != "" {
+			assumeRoleOpts = append(assumeRoleOpts, func(opts *stscreds.AssumeRoleOptions) {
+				opts.ExternalID = &cfg.AWSAssumeRoleExternalID
+			})
+		}
+		creds := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(config), cfg.AWSAssumeRole, assumeRoleOpts...)
+		config.Credentials = awsv2.NewCredentialsCache(creds)
+	}
+	return config, nil
+}
+
+// ociConfigFile returns the oci config file written from the provider secret of the ExternalDNS
+func ociConfigFile(cfg *externaldns.Config, cred *credentials.Credential) string {
+	if cred != nil && cred.FilePath != "" {
+		return cred.FilePath
+	}
+	return cfg.OCIConfigFile
+}
+
+// azureConfigFile returns the azure config file written from the provider secret of the ExternalDNS
+func azureConfigFile(cfg *externaldns.Config, cred *credentials.Credential) string {
+	if cred != nil && cred.FilePath != "" {
+		return cred.FilePath
+	}
+	return cfg.AzureConfigFile
+}
@@ -0,0 +1,69 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+)
+
+func TestConcurrentProviderCredentials(t *testing.T) {
+	const objects = 8
+
+	// every ExternalDNS has its own token and API server, which rejects the token of the others
+	creds := make([]*credentials.Credential, objects)
+	for i := range creds {
+		token := fmt.Sprintf("linode-token-%d", i)
+		server, _ := startTokenServer(t, "/v4/domains", "Authorization", "Bearer "+token, `{"data":[],"page":1,"pages":1,"results":0}`)
+		creds[i] = &credentials.Credential{Token: &credentials.TokenCredential{Token: token, URL: server.URL}}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, objects)
+	for i := range creds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cfg := convertEDNSObjectToCfg(&api.ExternalDNS{Spec: api.ExternalDNSSpec{Provider: api.ProviderLinode}})
+			p, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), creds[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			_, errs[i] = p.Records(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("ExternalDNS %d did not use its own credential: %v", i, err)
+		}
+	}
+	// the token is handed to the client, it is never set in the environment
+	for _, key := range []string{"LINODE_TOKEN", "LINODE_URL"} {
+		if _, found := os.LookupEnv(key); found {
+			t.Fatalf("%s is set in the environment", key)
+		}
+	}
+}
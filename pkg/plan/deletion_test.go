@@ -0,0 +1,163 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+func TestOrphanRequiresOwnershipRecords(t *testing.T) {
+	tests := []struct {
+		provider api.Provider
+		registry api.RegistryType
+		wantErr  bool
+	}{
+		{provider: api.ProviderAWS, registry: api.RegistryTXT},
+		{provider: api.ProviderAWS, registry: api.RegistryNoop},
+		{provider: api.ProviderAWS, registry: api.RegistryDynamoDB, wantErr: true},
+		{provider: api.ProviderAWSSD, registry: api.RegistryAWSSD, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		edns := &api.ExternalDNS{
+			Spec: api.ExternalDNSSpec{
+				Source:         api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+				Provider:       tc.provider,
+				DomainFilter:   []string{"example.com"},
+				Registry:       &api.Registry{Type: tc.registry},
+				DeletionPolicy: ptr.To(api.DeletionPolicyOrphan),
+			},
+		}
+		if err := validateConfig(convertEDNSObjectToCfg(edns), &edns.Spec); (err != nil) != tc.wantErr {
+			t.Errorf("registry %s: got error %v, want error %v", tc.registry, err, tc.wantErr)
+		}
+
+		// the other deletion policies do not depend on the ownership records
+		edns.Spec.DeletionPolicy = ptr.To(api.DeletionPolicyRetain)
+		if err := validateConfig(convertEDNSObjectToCfg(edns), &edns.Spec); err != nil {
+			t.Errorf("registry %s: unexpected error with the %s deletion policy: %v", tc.registry, api.DeletionPolicyRetain, err)
+		}
+	}
+}
+
+func TestDeletionPolicy(t *testing.T) {
+	www := endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")
+	foreign := endpoint.NewEndpoint("foreign.example.com", endpoint.RecordTypeA, "192.0.2.20")
+
+	tests := []struct {
+		policy api.DeletionPolicy
+		// want are the record types left for each name
+		want map[string][]string
+	}{
+		{
+			policy: api.DeletionPolicyDelete,
+			want:   map[string][]string{"foreign.example.com": {endpoint.RecordTypeA}},
+		},
+		{
+			policy: api.DeletionPolicyOrphan,
+			want:   map[string][]string{"www.example.com": {endpoint.RecordTypeA}, "foreign.example.com": {endpoint.RecordTypeA}},
+		},
+		{
+			policy: api.DeletionPolicyRetain,
+			want:   nil, // every record is kept
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			edns := &api.ExternalDNS{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "deletion-" + string(tc.policy)},
+				Spec: api.ExternalDNSSpec{
+					Source:         api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+					Provider:       api.ProviderInMemory,
+					DomainFilter:   []string{"example.com"},
+					DeletionPolicy: ptr.To(tc.policy),
+				},
+			}
+			t.Cleanup(func() {
+				ForgetComponents(edns)
+				ForgetInMemoryRecords(edns)
+			})
+
+			cfg := convertEDNSObjectToCfg(edns)
+			domainFilter := createDomainFilter(cfg)
+			pvdr, err := newProvider(context.Background(), edns, cfg, domainFilter, nil)
+			if err != nil {
+				t.Fatalf("failed to build provider: %v", err)
+			}
+			reg, err := createRegistry(context.Background(), edns, cfg, pvdr, nil)
+			if err != nil {
+				t.Fatalf("failed to create registry: %v", err)
+			}
+			if _, err := createAndApplyPlan(context.Background(), cfg, reg, staticSource{www}, domainFilter, newPlanGuard(edns)); err != nil {
+				t.Fatalf("failed to apply plan: %v", err)
+			}
+			// a record created outside of the operator, without an ownership record
+			if err := pvdr.ApplyChanges(context.Background(), &plan.Changes{Create: []*endpoint.Endpoint{foreign}}); err != nil {
+				t.Fatalf("failed to create the foreign record: %v", err)
+			}
+
+			records := func() []api.InMemoryRecord {
+				t.Helper()
+				records, err := inMemoryRecords(context.Background(), inMemoryProvider(edns, cfg, domainFilter))
+				if err != nil {
+					t.Fatalf("failed to list records: %v", err)
+				}
+				return records
+			}
+			before := records()
+			if len(before) != 3 {
+				t.Fatalf("expected the owned record, its ownership record and the foreign record, got %v", before)
+			}
+
+			// the cleanup run by the controller for each deletion policy
+			switch tc.policy {
+			case api.DeletionPolicyDelete:
+				err = DeleteDNSRecords(context.Background(), edns, nil)
+			case api.DeletionPolicyOrphan:
+				err = OrphanDNSRecords(context.Background(), edns, nil)
+			}
+			if err != nil {
+				t.Fatalf("failed to clean up the records: %v", err)
+			}
+
+			got := records()
+			if tc.want == nil {
+				if !reflect.DeepEqual(got, before) {
+					t.Fatalf("records changed from %v to %v", before, got)
+				}
+				return
+			}
+			left := map[string][]string{}
+			for _, rec := range got {
+				left[rec.Name] = append(left[rec.Name], rec.Type)
+			}
+			if !reflect.DeepEqual(left, tc.want) {
+				t.Fatalf("records left %v, want %v", left, tc.want)
+			}
+		})
+	}
+}
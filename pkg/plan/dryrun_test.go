@@ -0,0 +1,142 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+func TestPendingChanges(t *testing.T) {
+	changes := &plan.Changes{
+		Create: []*endpoint.Endpoint{
+			endpoint.NewEndpointWithTTL("b.example.com", endpoint.RecordTypeA, 300, "192.0.2.2", "192.0.2.1"),
+			endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeCNAME, "lb.example.com"),
+			endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.3"),
+		},
+		Delete: []*endpoint.Endpoint{endpoint.NewEndpoint("old.example.com", endpoint.RecordTypeA, "192.0.2.4")},
+	}
+
+	got := pendingChanges(changes)
+	want := &api.PendingChanges{
+		Create: []api.DNSChange{
+			{Name: "a.example.com", Type: endpoint.RecordTypeA, Targets: []string{"192.0.2.3"}},
+			{Name: "a.example.com", Type: endpoint.RecordTypeCNAME, Targets: []string{"lb.example.com"}},
+			{Name: "b.example.com", Type: endpoint.RecordTypeA, Targets: []string{"192.0.2.1", "192.0.2.2"}, TTL: 300},
+		},
+		Delete: []api.DNSChange{{Name: "old.example.com", Type: endpoint.RecordTypeA, Targets: []string{"192.0.2.4"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("pendingChanges() = %+v, want %+v", got, want)
+	}
+
+	// the plan is not changed by sorting the pending changes
+	if changes.Create[0].Targets[0] != "192.0.2.2" {
+		t.Fatalf("the targets of the plan were sorted in place: %v", changes.Create[0].Targets)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "dry-run"},
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+		},
+	}
+	t.Cleanup(func() {
+		ForgetComponents(edns)
+		ForgetInMemoryRecords(edns)
+	})
+
+	sync := func(src staticSource) *SyncResult {
+		t.Helper()
+		cfg := convertEDNSObjectToCfg(edns)
+		domainFilter := createDomainFilter(cfg)
+		pvdr, err := newProvider(context.Background(), edns, cfg, domainFilter, nil)
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		reg, err := createRegistry(context.Background(), edns, cfg, pvdr, nil)
+		if err != nil {
+			t.Fatalf("failed to create registry: %v", err)
+		}
+		result, err := createAndApplyPlan(context.Background(), cfg, reg, src, domainFilter, newPlanGuard(edns))
+		if err != nil {
+			t.Fatalf("failed to apply plan: %v", err)
+		}
+		return result
+	}
+	records := func() []api.InMemoryRecord {
+		t.Helper()
+		cfg := convertEDNSObjectToCfg(edns)
+		records, err := inMemoryRecords(context.Background(), inMemoryProvider(edns, cfg, createDomainFilter(cfg)))
+		if err != nil {
+			t.Fatalf("failed to list records: %v", err)
+		}
+		return records
+	}
+	www := endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")
+
+	// computing the plan without applying it
+	edns.Spec.DryRun = ptr.To(true)
+	result := sync(staticSource{www})
+	if result.PendingChanges == nil || len(result.PendingChanges.Create) != 1 || result.PlanHash == "" {
+		t.Fatalf("expected the record to be pending, got %+v", result)
+	}
+	if got := records(); len(got) != 0 {
+		t.Fatalf("dry run applied the plan: %v", got)
+	}
+
+	// applying the plan once the dry run is disabled
+	edns.Spec.DryRun = nil
+	if result := sync(staticSource{www}); result.PendingChanges != nil || len(result.Records) != 1 {
+		t.Fatalf("unexpected result without dry run: %+v", result)
+	}
+
+	// keeping the records of an ExternalDNS deleted in dry run mode
+	edns.Spec.DryRun = ptr.To(true)
+	err := DeleteDNSRecords(context.Background(), edns, nil)
+	var dryRun *DryRunDeletionError
+	if !errors.As(err, &dryRun) {
+		t.Fatalf("expected a DryRunDeletionError, got %v", err)
+	}
+	if len(dryRun.PendingChanges.Delete) != 1 {
+		t.Fatalf("expected the record to be pending deletion, got %+v", dryRun.PendingChanges)
+	}
+	if got := records(); len(got) != 2 {
+		t.Fatalf("dry run deleted records: %v", got)
+	}
+
+	edns.Spec.DryRun = nil
+	if err := DeleteDNSRecords(context.Background(), edns, nil); err != nil {
+		t.Fatalf("failed to delete records: %v", err)
+	}
+	if got := records(); len(got) != 0 {
+		t.Fatalf("records left after the deletion: %v", got)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/registry"
+)
+
+const (
+	// dynamoDBKeyAttribute is the hash key of the table, required by the DynamoDB registry
+	dynamoDBKeyAttribute = "k"
+
+	// dynamoDBTableWaitTimeout bounds the wait for a created table to become active
+	dynamoDBTableWaitTimeout = 2 * time.Minute
+)
+
+// dynamoDBSpec returns the DynamoDB settings of the registry of the ExternalDNS, nil when they are not set
+func dynamoDBSpec(edns *api.ExternalDNS) *api.DynamoDBRegistry {
+	if edns.Spec.Registry == nil {
+		return nil
+	}
+	return edns.Spec.Registry.DynamoDB
+}
+
+// newDynamoDBRegistry returns the DynamoDB registry of the ExternalDNS, authenticated with the credentials of
+// the aws or aws-sd provider. The table is created first when spec.registry.dynamodb.createTable is set.
+func newDynamoDBRegistry(
+	ctx context.Context,
+	spec *api.DynamoDBRegistry,
+	cfg *externaldns.Config,
+	p provider.Provider,
+	cred *credentials.Credential,
+) (registry.Registry, error) {
+	awsConfig, err := createAWSConfig(ctx, cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+	client := dynamodb.NewFromConfig(awsConfig, func(opts *dynamodb.Options) {
+		if cfg.AWSDynamoDBRegion != "" {
+			opts.Region = cfg.AWSDynamoDBRegion
+		}
+		if spec != nil && spec.Endpoint != nil {
+			opts.BaseEndpoint = spec.Endpoint
+		}
+	})
+
+	if spec != nil && spec.CreateTable != nil && *spec.CreateTable {
+		if err = ensureDynamoDBTable(ctx, client, cfg.AWSDynamoDBTable); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry.NewDynamoDBRegistry(p, cfg.TXTOwnerID, client, cfg.AWSDynamoDBTable, cfg.TXTPrefix, cfg.TXTSuffix, cfg.TXTWildcardReplacement, cfg.ManagedDNSRecordTypes, cfg.ExcludeDNSRecordTypes, []byte(cfg.TXTEncryptAESKey), cfg.TXTCacheInterval)
+}
+
+// ensureDynamoDBTable creates the table with the key schema of the DynamoDB registry, when it does not exist,
+// and waits for it to become active. An existing table is left as is, the registry checks its key schema.
+func ensureDynamoDBTable(ctx context.Context, client *dynamodb.Client, table string) error {
+	_, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: awsv2.String(table)})
+	if err == nil {
+		return nil
+	}
+	var notFound *dynamodbtypes.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		return fmt.Errorf("describing table %q: %w", table, err)
+	}
+
+	klog.InfoS("creating the table of the DynamoDB registry", "table", table)
+	_, err = client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: awsv2.String(table),
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{
+			{AttributeName: awsv2.String(dynamoDBKeyAttribute), AttributeType: dynamodbtypes.ScalarAttributeTypeS},
+		},
+		KeySchema: []dynamodbtypes.KeySchemaElement{
+			{AttributeName: awsv2.String(dynamoDBKeyAttribute), KeyType: dynamodbtypes.KeyTypeHash},
+		},
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+	})
+	var inUse *dynamodbtypes.ResourceInUseException
+	if err != nil && !errors.As(err, &inUse) { // another operator may create the same table
+		return fmt.Errorf("creating table %q: %w", table, err)
+	}
+
+	waiter := dynamodb.NewTableExistsWaiter(client, func(opts *dynamodb.TableExistsWaiterOptions) {
+		opts.MinDelay = 2 * time.Second
+		opts.MaxDelay = 10 * time.Second
+	})
+	if err = waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: awsv2.String(table)}, dynamoDBTableWaitTimeout); err != nil {
+		return fmt.Errorf("waiting for table %q: %w", table, err)
+	}
+	return nil
+}
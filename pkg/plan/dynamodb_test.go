@@ -0,0 +1,172 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/provider/inmemory"
+)
+
+// dynamoDBServer serves the DynamoDB API calls of the registry for a single table, like DynamoDB Local
+type dynamoDBServer struct {
+	mu      sync.Mutex
+	created []map[string]any
+}
+
+func (s *dynamoDBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var input map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&input)
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+
+	table := map[string]any{
+		"TableName":            input["TableName"],
+		"TableStatus":          "ACTIVE",
+		"AttributeDefinitions": []map[string]string{{"AttributeName": "k", "AttributeType": "S"}},
+		"KeySchema":            []map[string]string{{"AttributeName": "k", "KeyType": "HASH"}},
+	}
+	switch strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDB_20120810.") {
+	case "DescribeTable":
+		if len(s.created) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Table": table})
+	case "CreateTable":
+		s.created = append(s.created, input)
+		_ = json.NewEncoder(w).Encode(map[string]any{"TableDescription": table})
+	case "Scan":
+		_, _ = w.Write([]byte(`{"Items":[],"Count":0,"ScannedCount":0}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestDynamoDBRegistryCreatesTable(t *testing.T) {
+	server := &dynamoDBServer{}
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	// the registry authenticates with the shared credentials file of the aws provider
+	dir := t.TempDir()
+	credFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credFile, []byte("[default]\naws_access_key_id = test\naws_secret_access_key = test\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderAWS,
+			DomainFilter: []string{"example.com"},
+			Registry: &api.Registry{
+				Type: api.RegistryDynamoDB,
+				DynamoDB: &api.DynamoDBRegistry{
+					Table:       ptr.To("dns-owners"),
+					Region:      ptr.To("us-east-1"),
+					Endpoint:    ptr.To(ts.URL),
+					CreateTable: ptr.To(true),
+				},
+			},
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+	if err := validateConfig(cfg, &edns.Spec); err != nil {
+		t.Fatal(err)
+	}
+	cred := &credentials.Credential{FilePath: credFile}
+	pvdr := inmemory.NewInMemoryProvider(inmemory.InMemoryInitZones([]string{"example.com"}))
+
+	reg, err := createRegistry(context.Background(), edns, cfg, pvdr, cred)
+	if err != nil {
+		t.Fatalf("failed to create registry: %v", err)
+	}
+	if _, err = reg.Records(context.Background()); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+
+	if len(server.created) != 1 {
+		t.Fatalf("the table is created %d times, want once", len(server.created))
+	}
+	created, _ := json.Marshal(server.created[0])
+	for _, want := range []string{
+		`"TableName":"dns-owners"`,
+		`"AttributeDefinitions":[{"AttributeName":"k","AttributeType":"S"}]`,
+		`"KeySchema":[{"AttributeName":"k","KeyType":"HASH"}]`,
+	} {
+		if !strings.Contains(string(created), want) {
+			t.Errorf("CreateTable input %s does not contain %s", created, want)
+		}
+	}
+
+	// an existing table is not created again
+	if _, err = createRegistry(context.Background(), edns, cfg, pvdr, cred); err != nil {
+		t.Fatalf("failed to create registry: %v", err)
+	}
+	if len(server.created) != 1 {
+		t.Fatalf("the existing table is created again")
+	}
+}
+
+func TestDynamoDBRegistryRequiresAWSProvider(t *testing.T) {
+	tests := []struct {
+		provider api.Provider
+		registry api.RegistryType
+		dynamoDB *api.DynamoDBRegistry
+		wantErr  bool
+	}{
+		{provider: api.ProviderAWS, registry: api.RegistryDynamoDB},
+		{provider: api.ProviderAWSSD, registry: api.RegistryDynamoDB},
+		{provider: api.ProviderCloudflare, registry: api.RegistryDynamoDB, wantErr: true},
+		{provider: api.ProviderInMemory, registry: api.RegistryDynamoDB, wantErr: true},
+		{provider: api.ProviderAWS, registry: api.RegistryDynamoDB, dynamoDB: &api.DynamoDBRegistry{Table: ptr.To("dns-owners")}},
+		// the table settings are rejected with the other registries instead of being ignored
+		{provider: api.ProviderAWS, registry: api.RegistryTXT, dynamoDB: &api.DynamoDBRegistry{Table: ptr.To("dns-owners")}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		edns := &api.ExternalDNS{
+			Spec: api.ExternalDNSSpec{
+				Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+				Provider:     tc.provider,
+				DomainFilter: []string{"example.com"},
+				Registry:     &api.Registry{Type: tc.registry, DynamoDB: tc.dynamoDB},
+			},
+		}
+		if err := validateConfig(convertEDNSObjectToCfg(edns), &edns.Spec); (err != nil) != tc.wantErr {
+			t.Errorf("provider %s, registry %s: got error %v, want error %v", tc.provider, tc.registry, err, tc.wantErr)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"gomodules.xyz/sets"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+// DeleteLimitExceededError is reported when a plan deletes more records than spec.safety.maxDeletesPerSync allows
+type DeleteLimitExceededError struct {
+	Deletes int
+	Owned   int
+	Limit   int
+}
+
+func (e *DeleteLimitExceededError) Error() string {
+	return fmt.Sprintf("plan deletes %d of %d owned records, more than the %d allowed by spec.safety.maxDeletesPerSync", e.Deletes, e.Owned, e.Limit)
+}
+
+// IsDeleteLimitExceeded reports whether err is caused by a plan deleting too many records
+func IsDeleteLimitExceeded(err error) bool {
+	var target *DeleteLimitExceededError
+	return errors.As(err, &target)
+}
+
+// planGuard decides whether a computed plan may be applied to the provider
+type planGuard struct {
+	approval     api.ApprovalPolicy
+	approvedHash string
+
+	maxDeletes           *intstr.IntOrString
+	overrideDeleteSafety bool
+}
+
+func newPlanGuard(edns *api.ExternalDNS) planGuard {
+	guard := planGuard{
+		approval:             api.ApprovalAutomatic,
+		approvedHash:         edns.Annotations[api.ApprovePlanAnnotation],
+		overrideDeleteSafety: edns.Annotations[api.OverrideDeleteSafetyAnnotation] == "true",
+	}
+	if edns.Spec.Approval != nil {
+		guard.approval = *edns.Spec.Approval
+	}
+	if edns.Spec.Safety != nil {
+		guard.maxDeletes = edns.Spec.Safety.MaxDeletesPerSync
+	}
+	return guard
+}
+
+// checkDeletes returns a DeleteLimitExceededError when changes delete more of the owned records than allowed.
+// Percentages are rounded up, so a non-zero percentage always allows deleting a record.
+func (g planGuard) checkDeletes(changes *plan.Changes, owned int) error {
+	if g.maxDeletes == nil {
+		return nil
+	}
+	limit, err := intstr.GetScaledValueFromIntOrPercent(g.maxDeletes, owned, true)
+	if err != nil {
+		return fmt.Errorf("invalid spec.safety.maxDeletesPerSync: %w", err)
+	}
+	if len(changes.Delete) > limit {
+		return &DeleteLimitExceededError{Deletes: len(changes.Delete), Owned: owned, Limit: limit}
+	}
+	return nil
+}
+
+// ownedRecords counts the current records of the managed types that are owned by this instance
+func ownedRecords(cfg *externaldns.Config, records []*endpoint.Endpoint) int {
+	managed := sets.NewString(cfg.ManagedDNSRecordTypes...)
+	owned := 0
+	for _, rec := range records {
+		if !managed.Has(rec.RecordType) {
+			continue
+		}
+		// the noop registry keeps no ownership, every record is considered owned
+		if cfg.Registry == "noop" || rec.Labels[endpoint.OwnerLabelKey] == cfg.TXTOwnerID {
+			owned++
+		}
+	}
+	return owned
+}
+
+// approved reports whether changes, whose content hash is hash, may be applied
+func (g planGuard) approved(changes *plan.Changes, hash string) bool {
+	switch g.approval {
+	case api.ApprovalManual:
+		return g.approvedHash == hash
+	case api.ApprovalDeletesOnly:
+		return len(changes.Delete) == 0 || g.approvedHash == hash
+	}
+	return true
+}
+
+// pendingChanges converts the changes of a plan to the form published in the status. The records
+// are sorted, so the status and the plan hash do not depend on the order the plan is computed in.
+func pendingChanges(changes *plan.Changes) *api.PendingChanges {
+	convert := func(endpoints []*endpoint.Endpoint) []api.DNSChange {
+		if len(endpoints) == 0 {
+			return nil
+		}
+		out := make([]api.DNSChange, 0, len(endpoints))
+		for _, ep := range endpoints {
+			targets := slices.Clone(ep.Targets)
+			slices.Sort(targets)
+			var props []api.ProviderSpecificProperty
+			for _, prop := range ep.ProviderSpecific {
+				props = append(props, api.ProviderSpecificProperty{Name: prop.Name, Value: prop.Value})
+			}
+			slices.SortFunc(props, compareProviderSpecific)
+			out = append(out, api.DNSChange{
+				Name:             ep.DNSName,
+				Type:             ep.RecordType,
+				Targets:          targets,
+				TTL:              int64(ep.RecordTTL),
+				SetIdentifier:    ep.SetIdentifier,
+				ProviderSpecific: props,
+			})
+		}
+		slices.SortFunc(out, func(a, b api.DNSChange) int {
+			if c := strings.Compare(a.Name, b.Name); c != 0 {
+				return c
+			}
+			if c := strings.Compare(a.Type, b.Type); c != 0 {
+				return c
+			}
+			if c := strings.Compare(a.SetIdentifier, b.SetIdentifier); c != 0 {
+				return c
+			}
+			if c := slices.Compare(a.Targets, b.Targets); c != 0 {
+				return c
+			}
+			return slices.CompareFunc(a.ProviderSpecific, b.ProviderSpecific, compareProviderSpecific)
+		})
+		return out
+	}
+	return &api.PendingChanges{
+		Create:    convert(changes.Create),
+		UpdateOld: convert(changes.UpdateOld),
+		UpdateNew: convert(changes.UpdateNew),
+		Delete:    convert(changes.Delete),
+	}
+}
+
+func compareProviderSpecific(a, b api.ProviderSpecificProperty) int {
+	if c := strings.Compare(a.Name, b.Name); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Value, b.Value)
+}
+
+// planHash returns the content hash of the pending changes, a plan with different changes gets a different hash
+func planHash(changes *api.PendingChanges) string {
+	data, _ := json.Marshal(changes) // nolint:errchkjson
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,211 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+func TestPlanHashIgnoresOrder(t *testing.T) {
+	a := endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.1", "192.0.2.2")
+	b := endpoint.NewEndpoint("b.example.com", endpoint.RecordTypeA, "192.0.2.3")
+	aReordered := endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.2", "192.0.2.1")
+
+	h1 := planHash(pendingChanges(&plan.Changes{Create: []*endpoint.Endpoint{a, b}}))
+	h2 := planHash(pendingChanges(&plan.Changes{Create: []*endpoint.Endpoint{b, aReordered}}))
+	if h1 != h2 {
+		t.Fatalf("hash depends on order: %s != %s", h1, h2)
+	}
+
+	h3 := planHash(pendingChanges(&plan.Changes{Delete: []*endpoint.Endpoint{a, b}}))
+	if h1 == h3 {
+		t.Fatalf("creates and deletes of the same records must not share a hash")
+	}
+}
+
+func TestPlanHashRoutingFields(t *testing.T) {
+	record := func(setID string, props ...endpoint.ProviderSpecificProperty) *endpoint.Endpoint {
+		ep := endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.1").WithSetIdentifier(setID)
+		ep.ProviderSpecific = props
+		return ep
+	}
+	weight := func(v string) endpoint.ProviderSpecificProperty {
+		return endpoint.ProviderSpecificProperty{Name: "aws/weight", Value: v}
+	}
+	region := endpoint.ProviderSpecificProperty{Name: "aws/region", Value: "us-east-1"}
+
+	hash := func(eps ...*endpoint.Endpoint) string {
+		return planHash(pendingChanges(&plan.Changes{Create: eps}))
+	}
+	if hash(record("blue", weight("10"))) == hash(record("green", weight("10"))) {
+		t.Fatalf("plans differing only in the set identifier must not share a hash")
+	}
+	if hash(record("blue", weight("10"))) == hash(record("blue", weight("90"))) {
+		t.Fatalf("plans differing only in provider specific properties must not share a hash")
+	}
+	if hash(record("blue", weight("10"), region)) != hash(record("blue", region, weight("10"))) {
+		t.Fatalf("hash depends on the order of provider specific properties")
+	}
+	if hash(record("blue"), record("green")) != hash(record("green"), record("blue")) {
+		t.Fatalf("hash depends on the order of records with different set identifiers")
+	}
+
+	changes := pendingChanges(&plan.Changes{Create: []*endpoint.Endpoint{record("blue", weight("10"))}})
+	got := changes.Create[0]
+	if got.SetIdentifier != "blue" || len(got.ProviderSpecific) != 1 || got.ProviderSpecific[0] != (api.ProviderSpecificProperty{Name: "aws/weight", Value: "10"}) {
+		t.Fatalf("unexpected pending change %+v", got)
+	}
+}
+
+func TestPlanGuardApproved(t *testing.T) {
+	creates := &plan.Changes{Create: []*endpoint.Endpoint{endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.1")}}
+	deletes := &plan.Changes{Delete: []*endpoint.Endpoint{endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.1")}}
+	const hash = "0123456789abcdef"
+
+	tests := []struct {
+		name    string
+		guard   planGuard
+		changes *plan.Changes
+		want    bool
+	}{
+		{name: "automatic", guard: planGuard{approval: api.ApprovalAutomatic}, changes: deletes, want: true},
+		{name: "manual without approval", guard: planGuard{approval: api.ApprovalManual}, changes: creates, want: false},
+		{name: "manual with stale approval", guard: planGuard{approval: api.ApprovalManual, approvedHash: "stale"}, changes: creates, want: false},
+		{name: "manual approved", guard: planGuard{approval: api.ApprovalManual, approvedHash: hash}, changes: creates, want: true},
+		{name: "deletes only without deletes", guard: planGuard{approval: api.ApprovalDeletesOnly}, changes: creates, want: true},
+		{name: "deletes only with deletes", guard: planGuard{approval: api.ApprovalDeletesOnly}, changes: deletes, want: false},
+		{name: "deletes only approved", guard: planGuard{approval: api.ApprovalDeletesOnly, approvedHash: hash}, changes: deletes, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.guard.approved(tc.changes, hash); got != tc.want {
+				t.Fatalf("approved = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlanGuardCheckDeletes(t *testing.T) {
+	deletes := func(n int) *plan.Changes {
+		changes := &plan.Changes{}
+		for i := 0; i < n; i++ {
+			changes.Delete = append(changes.Delete, endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "192.0.2.1"))
+		}
+		return changes
+	}
+	limit := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+
+	tests := []struct {
+		name    string
+		max     *intstr.IntOrString
+		deletes int
+		owned   int
+		wantErr bool
+	}{
+		{name: "no limit", max: nil, deletes: 100, owned: 100, wantErr: false},
+		{name: "absolute within", max: limit(intstr.FromInt32(5)), deletes: 5, owned: 100, wantErr: false},
+		{name: "absolute exceeded", max: limit(intstr.FromInt32(5)), deletes: 6, owned: 100, wantErr: true},
+		{name: "percentage within", max: limit(intstr.FromString("25%")), deletes: 25, owned: 100, wantErr: false},
+		{name: "percentage exceeded", max: limit(intstr.FromString("25%")), deletes: 26, owned: 100, wantErr: true},
+		{name: "percentage rounds up", max: limit(intstr.FromString("10%")), deletes: 1, owned: 5, wantErr: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := planGuard{maxDeletes: tc.max}.checkDeletes(deletes(tc.deletes), tc.owned)
+			if tc.wantErr != IsDeleteLimitExceeded(err) {
+				t.Fatalf("checkDeletes = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlanReapproval(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "reapproval"},
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+			Approval:     ptr.To(api.ApprovalManual),
+		},
+	}
+	t.Cleanup(func() {
+		ForgetComponents(edns)
+		ForgetInMemoryRecords(edns)
+	})
+
+	src := staticSource{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")}
+	sync := func() *SyncResult {
+		t.Helper()
+		cfg := convertEDNSObjectToCfg(edns)
+		domainFilter := createDomainFilter(cfg)
+		pvdr, err := newProvider(context.Background(), edns, cfg, domainFilter, nil)
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		reg, err := createRegistry(context.Background(), edns, cfg, pvdr, nil)
+		if err != nil {
+			t.Fatalf("failed to create registry: %v", err)
+		}
+		result, err := createAndApplyPlan(context.Background(), cfg, reg, src, domainFilter, newPlanGuard(edns))
+		if err != nil {
+			t.Fatalf("failed to apply plan: %v", err)
+		}
+		// the controller removes the annotation of an applied plan
+		if result.ApprovalApplied {
+			delete(edns.Annotations, api.ApprovePlanAnnotation)
+		}
+		return result
+	}
+
+	result := sync()
+	if !result.WaitingForApproval || result.PlanHash == "" {
+		t.Fatalf("expected the plan to wait for approval, got %+v", result)
+	}
+	hash := result.PlanHash
+
+	edns.Annotations = map[string]string{api.ApprovePlanAnnotation: hash}
+	if result := sync(); result.WaitingForApproval || !result.ApprovalApplied || len(result.Records) != 1 {
+		t.Fatalf("expected the approved plan to be applied, got %+v", result)
+	}
+	if _, ok := edns.Annotations[api.ApprovePlanAnnotation]; ok {
+		t.Fatalf("the approval was kept after its plan was applied")
+	}
+
+	// the records are removed outside of the operator, the same plan is computed again
+	ForgetInMemoryRecords(edns)
+	result = sync()
+	if !result.WaitingForApproval || result.PlanHash != hash {
+		t.Fatalf("expected the same plan to wait for a new approval, got %+v", result)
+	}
+
+	edns.Annotations = map[string]string{api.ApprovePlanAnnotation: hash}
+	if result := sync(); result.WaitingForApproval || !result.ApprovalApplied {
+		t.Fatalf("expected the re-approved plan to be applied, got %+v", result)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"sync"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/provider/inmemory"
+)
+
+// inMemoryState is the inmemory provider of an ExternalDNS, along with the zones and the domain filter it was created with
+type inMemoryState struct {
+	zones    []string
+	filter   string
+	provider *inmemory.InMemoryProvider
+}
+
+var (
+	// inMemoryStates keeps the inmemory provider of every ExternalDNS, so its records persist across
+	// reconciles. The reconciles of one ExternalDNS are serialized, so a provider is never used concurrently.
+	inMemoryStates = map[types.NamespacedName]*inMemoryState{}
+	inMemoryMu     sync.Mutex
+)
+
+// inMemoryProvider returns the inmemory provider of the ExternalDNS. It is created again, without records,
+// when its zones or its domain filter change.
+func inMemoryProvider(edns *api.ExternalDNS, cfg *externaldns.Config, domainFilter *endpoint.DomainFilter) *inmemory.InMemoryProvider {
+	key := types.NamespacedName{Namespace: edns.Namespace, Name: edns.Name}
+	zones := cfg.InMemoryZones
+	if len(zones) == 0 {
+		zones = cfg.DomainFilter
+	}
+	// the filter is marshaled with its exclusions and regular expressions
+	filter, _ := json.Marshal(domainFilter) // nolint:errchkjson
+
+	inMemoryMu.Lock()
+	defer inMemoryMu.Unlock()
+
+	if state, found := inMemoryStates[key]; found && slices.Equal(state.zones, zones) && state.filter == string(filter) {
+		return state.provider
+	}
+	p := inmemory.NewInMemoryProvider(
+		inmemory.InMemoryInitZones(zones),
+		inmemory.InMemoryWithDomain(domainFilter),
+		inmemory.InMemoryWithLogging(),
+	)
+	inMemoryStates[key] = &inMemoryState{zones: slices.Clone(zones), filter: string(filter), provider: p}
+	return p
+}
+
+// ForgetInMemoryRecords drops the inmemory provider of the ExternalDNS along with its records
+func ForgetInMemoryRecords(edns *api.ExternalDNS) {
+	inMemoryMu.Lock()
+	defer inMemoryMu.Unlock()
+
+	delete(inMemoryStates, types.NamespacedName{Namespace: edns.Namespace, Name: edns.Name})
+}
+
+// inMemoryRecords returns the records held by the inmemory provider, sorted by name and type
+func inMemoryRecords(ctx context.Context, p *inmemory.InMemoryProvider) ([]api.InMemoryRecord, error) {
+	endpoints, err := p.Records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]api.InMemoryRecord, 0, len(endpoints))
+	for _, ep := range endpoints {
+		records = append(records, api.InMemoryRecord{
+			Name:    ep.DNSName,
+			Type:    ep.RecordType,
+			Targets: slices.Clone(ep.Targets),
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		return records[i].Type < records[j].Type
+	})
+	return records, nil
+}
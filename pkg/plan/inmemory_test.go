@@ -0,0 +1,132 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/external-dns/endpoint"
+)
+
+// staticSource returns the same endpoints on every sync
+type staticSource []*endpoint.Endpoint
+
+func (s staticSource) Endpoints(context.Context) ([]*endpoint.Endpoint, error) {
+	return s, nil
+}
+
+func (s staticSource) AddEventHandler(context.Context, func()) {}
+
+func TestInMemoryRecordsPersistAcrossSyncs(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "inmemory"},
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+		},
+	}
+	t.Cleanup(func() { ForgetInMemoryRecords(edns) })
+
+	// every sync builds the provider and the registry again, like a reconcile after the spec changed
+	sync := func(src staticSource) *SyncResult {
+		t.Helper()
+		cfg := convertEDNSObjectToCfg(edns)
+		domainFilter := createDomainFilter(cfg)
+		pvdr, err := newProvider(context.Background(), edns, cfg, domainFilter, nil)
+		if err != nil {
+			t.Fatalf("failed to build provider: %v", err)
+		}
+		reg, err := createRegistry(context.Background(), edns, cfg, pvdr, nil)
+		if err != nil {
+			t.Fatalf("failed to create registry: %v", err)
+		}
+		result, err := createAndApplyPlan(context.Background(), cfg, reg, src, domainFilter, newPlanGuard(edns))
+		if err != nil {
+			t.Fatalf("failed to apply plan: %v", err)
+		}
+		return result
+	}
+
+	www := endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")
+	if result := sync(staticSource{www}); len(result.Records) != 1 {
+		t.Fatalf("unexpected records of the first sync: %v", result.Records)
+	}
+
+	// the provider holds the record and its ownership record
+	cfg := convertEDNSObjectToCfg(edns)
+	pvdr := inMemoryProvider(edns, cfg, createDomainFilter(cfg))
+	records, err := inMemoryRecords(context.Background(), pvdr)
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	var names []string
+	for _, rec := range records {
+		names = append(names, rec.Type+" "+rec.Name)
+	}
+	if len(records) != 2 || records[1].Name != "www.example.com" || records[1].Type != endpoint.RecordTypeA {
+		t.Fatalf("unexpected in-memory records: %v", names)
+	}
+
+	// the second sync only creates the new record, since the first one is still current
+	apiEP := endpoint.NewEndpoint("api.example.com", endpoint.RecordTypeA, "192.0.2.20")
+	result := sync(staticSource{www, apiEP})
+	if result.PendingChanges != nil || len(result.Records) != 2 {
+		t.Fatalf("unexpected result of the second sync: %+v", result)
+	}
+	if records, _ = inMemoryRecords(context.Background(), pvdr); len(records) != 4 {
+		t.Fatalf("expected the records of both syncs and their ownership records, got %v", records)
+	}
+
+	// forgetting the ExternalDNS drops its records
+	ForgetInMemoryRecords(edns)
+	if records, _ = inMemoryRecords(context.Background(), inMemoryProvider(edns, cfg, createDomainFilter(cfg))); len(records) != 0 {
+		t.Fatalf("unexpected records after forgetting the ExternalDNS: %v", records)
+	}
+}
+
+func TestInMemoryProviderKeyedByFilter(t *testing.T) {
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "inmemory-filter"},
+		Spec: api.ExternalDNSSpec{
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+			InMemory:     &api.InMemoryProvider{Zones: []string{"example.com"}},
+		},
+	}
+	t.Cleanup(func() { ForgetInMemoryRecords(edns) })
+
+	provider := func() any {
+		cfg := convertEDNSObjectToCfg(edns)
+		return inMemoryProvider(edns, cfg, createDomainFilter(cfg))
+	}
+
+	first := provider()
+	if provider() != first {
+		t.Fatal("the provider is created again for unchanged zones and filter")
+	}
+
+	// the zones are unchanged, but the provider applies the filter
+	edns.Spec.ExcludeDomains = []string{"internal.example.com"}
+	if provider() == first {
+		t.Fatal("the provider is reused after the domain filter changed")
+	}
+}
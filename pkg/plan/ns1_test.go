@@ -0,0 +1,63 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestNS1IgnoreSSLKeepsDefaultClient(t *testing.T) {
+	transport := http.DefaultClient.Transport
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:   api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider: api.ProviderNS1,
+			NS1:      &api.NS1Provider{IgnoreSSL: ptr.To(true)},
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+	cred := &credentials.Credential{Token: &credentials.TokenCredential{Token: "ns1-key"}}
+
+	if _, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), cred); err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	if http.DefaultClient.Transport != transport {
+		t.Fatal("building the ns1 provider changed the transport of http.DefaultClient")
+	}
+}
+
+func TestNS1ProviderWithoutCredential(t *testing.T) {
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:   api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider: api.ProviderNS1,
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+
+	if _, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), &credentials.Credential{}); err == nil {
+		t.Fatal("expected an error without the NS1 API key")
+	}
+}
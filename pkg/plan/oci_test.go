@@ -0,0 +1,118 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func writeOCIConfig(t *testing.T) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	data, err := yaml.Marshal(map[string]any{
+		"auth": map[string]any{
+			"region":      "us-ashburn-1",
+			"tenancy":     "ocid1.tenancy.oc1..test",
+			"user":        "ocid1.user.oc1..test",
+			"key":         string(keyPEM),
+			"fingerprint": "aa:bb:cc:dd:ee:ff:00:11:22:33:44:55:66:77:88:99",
+		},
+		"compartment": "ocid1.compartment.oc1..test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "oci.yaml")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOCIProvider(t *testing.T) {
+	configFile := writeOCIConfig(t)
+	missingFile := filepath.Join(t.TempDir(), "missing-oci.yaml")
+
+	tests := []struct {
+		name    string
+		spec    *api.OCIProvider
+		cred    *credentials.Credential
+		wantErr string
+	}{
+		{
+			name: "config file of the credential",
+			spec: &api.OCIProvider{ZoneScope: ptr.To("PRIVATE")},
+			cred: &credentials.Credential{FilePath: configFile},
+		},
+		{
+			name:    "missing config file of the credential",
+			spec:    &api.OCIProvider{},
+			cred:    &credentials.Credential{FilePath: missingFile},
+			wantErr: missingFile,
+		},
+		{
+			name:    "instance principal without compartment",
+			spec:    &api.OCIProvider{AuthMode: ptr.To(api.OCIAuthInstancePrincipal)},
+			cred:    &credentials.Credential{},
+			wantErr: "no compartment OCID",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edns := &api.ExternalDNS{
+				Spec: api.ExternalDNSSpec{
+					Provider: api.ProviderOCI,
+					OCI:      tc.spec,
+				},
+			}
+			cfg := convertEDNSObjectToCfg(edns)
+
+			_, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), tc.cred)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("failed to build provider: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("buildProvider error = %v, want an error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
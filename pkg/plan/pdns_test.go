@@ -0,0 +1,101 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns/validation"
+)
+
+const testPDNSAPIKey = "pdns-test-key"
+
+// startPDNSServer serves a single zone over TLS to requests carrying testPDNSAPIKey
+func startPDNSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/servers/localhost/zones", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"example.com.","name":"example.com."}]`))
+	})
+	mux.HandleFunc("/api/v1/servers/localhost/zones/example.com.", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"example.com.","name":"example.com.","rrsets":[{"name":"www.example.com.","type":"A","ttl":300,"records":[{"content":"192.0.2.10","disabled":false}]}]}`))
+	})
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != testPDNSAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPDNSRecordsWithCredential(t *testing.T) {
+	server := startPDNSServer(t)
+
+	// the provider verifies the server with the CA file written from the secret
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, ca, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderPDNS,
+			DomainFilter: []string{"example.com"},
+			PDNS: &api.PDNSProvider{
+				Server: server.URL,
+			},
+		},
+	}
+
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, &credentials.Credential{
+		APIKey: testPDNSAPIKey,
+		TLS:    &credentials.TLSCredential{CAFilePath: caFile},
+	})
+	if err := validation.ValidateConfig(cfg); err != nil {
+		t.Fatalf("config validation failed: %v", err)
+	}
+
+	pvdr, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), nil)
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	records, err := pvdr.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) != 1 || records[0].DNSName != "www.example.com" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns/validation"
+)
+
+const (
+	testPiholePassword = "pihole-test-password"
+	testPiholeToken    = "pihole-test-token"
+)
+
+// startPiholeServer logs in with testPiholePassword and serves a single A record to requests carrying its token
+func startPiholeServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/index.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("pw") != testPiholePassword {
+			_, _ = w.Write([]byte(`<html><body>Wrong password!</body></html>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<html><body><div id="token" hidden>` + testPiholeToken + `</div></body></html>`))
+	})
+	mux.HandleFunc("/admin/scripts/pi-hole/php/customdns.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("token") != testPiholeToken {
+			_, _ = w.Write([]byte(`Not allowed (login session invalid or expired, please relogin on the Pi-hole dashboard)!`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[["www.example.com","192.0.2.10"]]}`))
+	})
+	mux.HandleFunc("/admin/scripts/pi-hole/php/customcname.php", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPiholeRecordsWithCredential(t *testing.T) {
+	server := startPiholeServer(t)
+
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderPihole,
+			DomainFilter: []string{"example.com"},
+			Registry:     &api.Registry{Type: api.RegistryTXT},
+			Pihole: &api.PiholeProvider{
+				Server: server.URL,
+			},
+		},
+	}
+
+	cfg := convertEDNSObjectToCfg(edns)
+	if cfg.Registry != "noop" {
+		t.Fatalf("registry = %q, want noop", cfg.Registry)
+	}
+	applyCredential(cfg, &credentials.Credential{Password: testPiholePassword})
+	if err := validation.ValidateConfig(cfg); err != nil {
+		t.Fatalf("config validation failed: %v", err)
+	}
+
+	pvdr, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), nil)
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	records, err := pvdr.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) != 1 || records[0].DNSName != "www.example.com" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+}
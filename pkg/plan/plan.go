@@ -20,53 +20,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
 	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+	"kubeops.dev/external-dns-operator/pkg/informers"
 
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	sd "github.com/aws/aws-sdk-go-v2/service/servicediscovery"
 	log "github.com/sirupsen/logrus"
 	"gomodules.xyz/sets"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/external-dns/endpoint"
 	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
 	"sigs.k8s.io/external-dns/pkg/apis/externaldns/validation"
 	"sigs.k8s.io/external-dns/plan"
 	"sigs.k8s.io/external-dns/provider"
-	"sigs.k8s.io/external-dns/provider/akamai"
 	"sigs.k8s.io/external-dns/provider/alibabacloud"
 	"sigs.k8s.io/external-dns/provider/aws"
 	"sigs.k8s.io/external-dns/provider/awssd"
 	"sigs.k8s.io/external-dns/provider/azure"
-	"sigs.k8s.io/external-dns/provider/civo"
-	"sigs.k8s.io/external-dns/provider/cloudflare"
-	"sigs.k8s.io/external-dns/provider/coredns"
-	"sigs.k8s.io/external-dns/provider/digitalocean"
-	"sigs.k8s.io/external-dns/provider/dnsimple"
 	"sigs.k8s.io/external-dns/provider/exoscale"
-	"sigs.k8s.io/external-dns/provider/gandi"
 	"sigs.k8s.io/external-dns/provider/godaddy"
-	"sigs.k8s.io/external-dns/provider/google"
 	"sigs.k8s.io/external-dns/provider/inmemory"
-	"sigs.k8s.io/external-dns/provider/linode"
-	"sigs.k8s.io/external-dns/provider/ns1"
 	"sigs.k8s.io/external-dns/provider/oci"
-	"sigs.k8s.io/external-dns/provider/ovh"
 	"sigs.k8s.io/external-dns/provider/pdns"
 	"sigs.k8s.io/external-dns/provider/pihole"
 	"sigs.k8s.io/external-dns/provider/plural"
 	"sigs.k8s.io/external-dns/provider/rfc2136"
-	"sigs.k8s.io/external-dns/provider/scaleway"
 	"sigs.k8s.io/external-dns/provider/transip"
-	"sigs.k8s.io/external-dns/provider/webhook"
 	"sigs.k8s.io/external-dns/registry"
 	"sigs.k8s.io/external-dns/source"
 	"sigs.k8s.io/external-dns/source/annotations"
+	"sigs.k8s.io/external-dns/source/types"
 	"sigs.k8s.io/external-dns/source/wrappers"
 )
 
@@ -137,19 +129,36 @@ func newDefaultConfig() externaldns.Config {
 	return cfg
 }
 
-func SetDNSRecords(ctx context.Context, edns *api.ExternalDNS) ([]api.DNSRecord, error) {
-	cfg := convertEDNSObjectToCfg(edns)
+// SyncResult is the outcome of synchronizing the DNS records of an ExternalDNS
+type SyncResult struct {
+	// Records are the DNS records managed by the ExternalDNS
+	Records []api.DNSRecord
 
-	if err := validation.ValidateConfig(cfg); err != nil {
-		return nil, fmt.Errorf("config validation failed: %w", err)
-	}
+	// PendingChanges are the computed changes that were not applied, in dry run mode or waiting for approval
+	PendingChanges *api.PendingChanges
 
-	annotations.SetAnnotationPrefix(cfg.AnnotationPrefix)
-	if cfg.AnnotationPrefix != annotations.DefaultAnnotationPrefix {
-		log.Infof("Using custom annotation prefix: %s", cfg.AnnotationPrefix)
-	}
+	// PlanHash is the content hash of the pending changes
+	PlanHash string
+
+	// WaitingForApproval is set when the pending changes are not applied until the plan hash is approved
+	WaitingForApproval bool
+
+	// ApprovalApplied is set when the applied plan was approved by the approve-plan annotation, the annotation
+	// is removed so it does not approve a later plan with the same changes
+	ApprovalApplied bool
+
+	// DeleteLimitExceeded is set when the pending changes delete more records than allowed by spec.safety
+	DeleteLimitExceeded *DeleteLimitExceededError
+
+	// InMemoryRecords are the records held by the inmemory provider
+	InMemoryRecords []api.InMemoryRecord
+}
 
-	if err := configureLogger(cfg); err != nil {
+func SetDNSRecords(ctx context.Context, edns *api.ExternalDNS, cred *credentials.Credential) (*SyncResult, error) {
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, cred)
+
+	if err := validateConfig(cfg, &edns.Spec); err != nil {
 		return nil, err
 	}
 
@@ -162,7 +171,9 @@ func SetDNSRecords(ctx context.Context, edns *api.ExternalDNS) ([]api.DNSRecord,
 
 	log.Info(externaldns.Banner())
 
-	endpointsSource, err := createEndpointsSource(ctx, cfg)
+	c := getComponents(edns, cfg, cred)
+
+	endpointsSource, err := c.getSource(cfg, edns.Spec.GetSources())
 	if err != nil {
 		klog.ErrorS(err, "failed to create endpoints source")
 		return nil, err
@@ -170,48 +181,111 @@ func SetDNSRecords(ctx context.Context, edns *api.ExternalDNS) ([]api.DNSRecord,
 
 	domainFilter := createDomainFilter(cfg)
 
-	pvdr, err := buildProvider(ctx, cfg, domainFilter)
+	pvdr, err := c.getProvider(edns, cfg, domainFilter, cred)
 	if err != nil {
 		klog.ErrorS(err, "failed to build provider")
 		return nil, err
 	}
 
-	reg, err := createRegistry(cfg, pvdr)
+	reg, err := c.getRegistry(edns, cfg, pvdr, cred)
 	if err != nil {
 		klog.ErrorS(err, "failed to create registry")
 		return nil, err
 	}
 
-	dnsRecs, err := createAndApplyPlan(ctx, cfg, reg, endpointsSource, domainFilter)
+	if err = rotateTXTEncryption(ctx, cfg, pvdr, cred); err != nil {
+		return nil, err
+	}
+
+	result, err := createAndApplyPlan(ctx, cfg, reg, endpointsSource, domainFilter, newPlanGuard(edns))
 	if err != nil {
 		klog.ErrorS(err, "failed to apply plan")
 		return nil, err
 	}
 
-	return dnsRecs, nil
+	if sdProvider, ok := unwrapProvider(pvdr).(*awssd.AWSSDProvider); ok {
+		if err := setCloudMapIDs(ctx, sdProvider, result.Records); err != nil {
+			// the records are synced, only their Cloud Map ids are missing from the status
+			klog.ErrorS(err, "failed to read the Cloud Map services of the records")
+		}
+	}
+
+	if imProvider, ok := pvdr.(*inmemory.InMemoryProvider); ok {
+		if result.InMemoryRecords, err = inMemoryRecords(ctx, imProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
-// DeleteDNSRecords removes all DNS records owned by the given ExternalDNS instance by
-// running a sync plan with empty desired endpoints, causing the registry to delete
-// every record it tracks for this owner.
-func DeleteDNSRecords(ctx context.Context, edns *api.ExternalDNS) error {
-	cfg := convertEDNSObjectToCfg(edns)
+// validateConfig validates the config like external-dns does, along with the combinations of provider and
+// registry the operator does not support
+func validateConfig(cfg *externaldns.Config, spec *api.ExternalDNSSpec) error {
 	if err := validation.ValidateConfig(cfg); err != nil {
 		return fmt.Errorf("config validation failed: %w", err)
 	}
+	// the DynamoDB client is built with the credentials of the aws and aws-sd providers
+	if cfg.Registry == api.RegistryDynamoDB.String() && cfg.Provider != api.ProviderAWS.String() && cfg.Provider != api.ProviderAWSSD.String() {
+		return fmt.Errorf("the %s registry uses the AWS credentials of the %s or %s provider and cannot be used with the %s provider",
+			api.RegistryDynamoDB, api.ProviderAWS, api.ProviderAWSSD, cfg.Provider)
+	}
+	if spec.Registry != nil && spec.Registry.DynamoDB != nil && cfg.Registry != api.RegistryDynamoDB.String() {
+		return fmt.Errorf("spec.registry.dynamodb is only used by the %s registry, not by the %s registry", api.RegistryDynamoDB, cfg.Registry)
+	}
+	if spec.Registry != nil && spec.Registry.TXT != nil && cfg.Registry != api.RegistryTXT.String() {
+		return fmt.Errorf("spec.registry.txt is only used by the %s registry, not by the %s registry", api.RegistryTXT, cfg.Registry)
+	}
+	if cfg.TXTEncryptEnabled && cfg.Registry != api.RegistryTXT.String() {
+		return fmt.Errorf("encryption of the ownership records is not supported with the %s registry", cfg.Registry)
+	}
+	// the records are orphaned by deleting their ownership records, which only the txt registry keeps next to them
+	if spec.GetDeletionPolicy() == api.DeletionPolicyOrphan && cfg.Registry != api.RegistryTXT.String() && cfg.Registry != api.RegistryNoop.String() {
+		return fmt.Errorf("deletion policy %s is not supported with the %s registry", api.DeletionPolicyOrphan, cfg.Registry)
+	}
+	return nil
+}
+
+// SyncInterval returns the period the DNS records of the ExternalDNS are resynchronized with
+func SyncInterval(edns *api.ExternalDNS) time.Duration {
+	return convertEDNSObjectToCfg(edns).Interval
+}
+
+// DryRunDeletionError is reported when the records of a deleted ExternalDNS are kept because of the dry run
+// mode. The finalizer is kept as well, so the records are not left behind silently.
+type DryRunDeletionError struct {
+	PendingChanges *api.PendingChanges
+}
 
-	if err := configureLogger(cfg); err != nil {
+func (e *DryRunDeletionError) Error() string {
+	return fmt.Sprintf("dry run: %d records are not deleted", len(e.PendingChanges.Delete))
+}
+
+// IsDryRunDeletion reports whether err is caused by deleting the records of an ExternalDNS in dry run mode
+func IsDryRunDeletion(err error) bool {
+	var target *DryRunDeletionError
+	return errors.As(err, &target)
+}
+
+// DeleteDNSRecords removes all DNS records owned by the given ExternalDNS instance by
+// running a sync plan with empty desired endpoints, causing the registry to delete
+// every record it tracks for this owner.
+func DeleteDNSRecords(ctx context.Context, edns *api.ExternalDNS, cred *credentials.Credential) error {
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, cred)
+	if err := validateConfig(cfg, &edns.Spec); err != nil {
 		return err
 	}
 
 	domainFilter := createDomainFilter(cfg)
+	c := getComponents(edns, cfg, cred)
 
-	pvdr, err := buildProvider(ctx, cfg, domainFilter)
+	pvdr, err := c.getProvider(edns, cfg, domainFilter, cred)
 	if err != nil {
 		return err
 	}
 
-	reg, err := createRegistry(cfg, pvdr)
+	reg, err := c.getRegistry(edns, cfg, pvdr, cred)
 	if err != nil {
 		return err
 	}
@@ -240,7 +314,15 @@ func DeleteDNSRecords(ctx context.Context, edns *api.ExternalDNS) error {
 
 	pln = pln.Calculate()
 
-	if pln.Changes.HasChanges() {
+	if pln.Changes.HasChanges() && cfg.DryRun {
+		klog.Info("cleanup: dry run, DNS records are not deleted")
+		return &DryRunDeletionError{PendingChanges: pendingChanges(pln.Changes)}
+	} else if pln.Changes.HasChanges() {
+		if guard := newPlanGuard(edns); !guard.overrideDeleteSafety {
+			if err = guard.checkDeletes(pln.Changes, ownedRecords(cfg, records)); err != nil {
+				return err
+			}
+		}
 		if err = reg.ApplyChanges(ctx, pln.Changes); err != nil {
 			return err
 		}
@@ -252,6 +334,65 @@ func DeleteDNSRecords(ctx context.Context, edns *api.ExternalDNS) error {
 	return nil
 }
 
+// OrphanDNSRecords deletes the ownership records of the DNS records owned by the ExternalDNS and keeps the
+// DNS records themselves, so another owner can adopt them
+func OrphanDNSRecords(ctx context.Context, edns *api.ExternalDNS, cred *credentials.Credential) error {
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, cred)
+	if err := validateConfig(cfg, &edns.Spec); err != nil {
+		return err
+	}
+
+	if cfg.Registry == api.RegistryNoop.String() {
+		klog.Info("cleanup: noop registry keeps no ownership records to orphan")
+		return nil
+	}
+
+	domainFilter := createDomainFilter(cfg)
+
+	pvdr, err := getComponents(edns, cfg, cred).getProvider(edns, cfg, domainFilter, cred)
+	if err != nil {
+		return err
+	}
+
+	// the registry would delete the ownership records together with the records, so the
+	// ownership records are deleted through the provider directly
+	records, err := pvdr.Records(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list records: %w", err)
+	}
+
+	var ownership []*endpoint.Endpoint
+	for _, rec := range records {
+		if rec.RecordType != endpoint.RecordTypeTXT || !domainFilter.Match(rec.DNSName) {
+			continue
+		}
+		for _, target := range rec.Targets {
+			lbls, err := ownershipLabels(target, cfg, cred)
+			if err == nil && lbls[endpoint.OwnerLabelKey] == cfg.TXTOwnerID {
+				ownership = append(ownership, rec)
+				break
+			}
+		}
+	}
+
+	if len(ownership) == 0 {
+		klog.Info("cleanup: no ownership records to delete")
+		return nil
+	}
+	if cfg.DryRun {
+		klog.Info("cleanup: dry run, ownership records are not deleted")
+		return &DryRunDeletionError{PendingChanges: pendingChanges(&plan.Changes{Delete: ownership})}
+	}
+
+	ctx = context.WithValue(ctx, provider.RecordsContextKey, records)
+	if err = pvdr.ApplyChanges(ctx, &plan.Changes{Delete: ownership}); err != nil {
+		return err
+	}
+	klog.Infof("cleanup: %d ownership records deleted, DNS records are orphaned", len(ownership))
+	return nil
+}
+
 // RegexDomainFilter overrides DomainFilter
 func createDomainFilter(cfg *externaldns.Config) *endpoint.DomainFilter {
 	if cfg.RegexDomainFilter != nil && cfg.RegexDomainFilter.String() != "" {
@@ -261,8 +402,9 @@ func createDomainFilter(cfg *externaldns.Config) *endpoint.DomainFilter {
 	}
 }
 
-// create and apply dns plan, If plan is successfully applied then returns dns record, which defines the desired records of the plan
-func createAndApplyPlan(ctx context.Context, cfg *externaldns.Config, r registry.Registry, endpointSource source.Source, domainFilter *endpoint.DomainFilter) ([]api.DNSRecord, error) {
+// create and apply dns plan, If plan is successfully applied then returns dns record, which defines the desired records of the plan.
+// In dry run mode the plan is not applied, the computed changes are returned instead
+func createAndApplyPlan(ctx context.Context, cfg *externaldns.Config, r registry.Registry, endpointSource source.Source, domainFilter *endpoint.DomainFilter, guard planGuard) (*SyncResult, error) {
 	records, err := r.Records(ctx)
 	if err != nil {
 		return nil, errors.New("failed to list records, " + err.Error())
@@ -293,28 +435,61 @@ func createAndApplyPlan(ctx context.Context, cfg *externaldns.Config, r registry
 	klog.V(2).InfoS("plan computed", "desired", len(pln.Desired), "current", len(pln.Current))
 	klog.V(4).InfoS("plan endpoints", "desired", pln.Desired, "current", pln.Current)
 
-	dnsRecs := make([]api.DNSRecord, 0)
+	result := &SyncResult{
+		Records: make([]api.DNSRecord, 0),
+	}
 
 	managedRecordsTypes := sets.NewString()
 	for _, dnsType := range cfg.ManagedDNSRecordTypes {
 		managedRecordsTypes.Insert(dnsType)
 	}
 
-	if pln.Changes.HasChanges() {
+	apply := pln.Changes.HasChanges()
+	var hash string
+	if apply {
+		pending := pendingChanges(pln.Changes)
+		hash = planHash(pending)
+		var limitErr *DeleteLimitExceededError
+		if cfg.DryRun {
+			klog.Info("dry run: plan computed, changes are not applied")
+			apply = false
+		} else if err := guard.checkDeletes(pln.Changes, ownedRecords(cfg, records)); err != nil && guard.approvedHash != hash {
+			// an approval of this exact plan lifts the limit
+			if !errors.As(err, &limitErr) {
+				return nil, err
+			}
+			klog.InfoS("plan is not applied", "reason", limitErr.Error(), "hash", hash)
+			result.DeleteLimitExceeded = limitErr
+			apply = false
+		} else if !guard.approved(pln.Changes, hash) {
+			klog.InfoS("plan is waiting for approval", "hash", hash)
+			result.WaitingForApproval = true
+			apply = false
+		}
+		if !apply {
+			result.PendingChanges = pending
+			result.PlanHash = hash
+		}
+	}
+
+	if apply {
 		err = r.ApplyChanges(ctx, pln.Changes)
 		if err != nil {
 			klog.ErrorS(err, "failed to apply changes")
 			return nil, err
 		}
 		klog.Info("plan applied")
+		result.ApprovalApplied = guard.approvedHash == hash
 		// Changes were just applied; pln.Desired now reflects the actual DNS state.
 		for _, rec := range pln.Desired {
 			if managedRecordsTypes.Has(rec.RecordType) {
-				dnsRecs = append(dnsRecs, api.DNSRecord{Name: rec.DNSName, Target: rec.Targets.String()})
+				result.Records = append(result.Records, api.DNSRecord{Name: rec.DNSName, Target: rec.Targets.String()})
 			}
 		}
 	} else {
-		klog.Info("all records are already up to date")
+		if !pln.Changes.HasChanges() {
+			klog.Info("all records are already up to date")
+		}
 		// No changes applied; reflect what is actually in DNS for the names we manage.
 		desiredNames := sets.NewString()
 		for _, rec := range pln.Desired {
@@ -322,11 +497,11 @@ func createAndApplyPlan(ctx context.Context, cfg *externaldns.Config, r registry
 		}
 		for _, rec := range pln.Current {
 			if managedRecordsTypes.Has(rec.RecordType) && desiredNames.Has(rec.DNSName) {
-				dnsRecs = append(dnsRecs, api.DNSRecord{Name: rec.DNSName, Target: rec.Targets.String()})
+				result.Records = append(result.Records, api.DNSRecord{Name: rec.DNSName, Target: rec.Targets.String()})
 			}
 		}
 	}
-	return dnsRecs, nil
+	return result, nil
 }
 
 func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
@@ -339,10 +514,18 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 	if edns.Spec.RequestTimeout != nil {
 		config.RequestTimeout = *edns.Spec.RequestTimeout
 	}
+	if edns.Spec.Interval != nil {
+		config.Interval = edns.Spec.Interval.Duration
+	}
+	if edns.Spec.DryRun != nil {
+		config.DryRun = *edns.Spec.DryRun
+	}
 
 	// SOURCE
 	var sources []string
-	sources = append(sources, strings.ToLower(edns.Spec.Source.Type.Kind))
+	for _, src := range edns.Spec.GetSources() {
+		sources = append(sources, sourceName(src))
+	}
 	// sources[] must contain strings that are lower cased
 	config.Sources = sources
 
@@ -362,82 +545,6 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 		config.DefaultTargets = edns.Spec.DefaultTargets
 	}
 
-	// For Node
-	if edns.Spec.Source.Node != nil && edns.Spec.Source.Type.Kind == "Node" {
-		config.FQDNTemplate = edns.Spec.Source.Node.FQDNTemplate
-		if edns.Spec.Source.Node.AnnotationFilter != nil {
-			config.AnnotationFilter = *edns.Spec.Source.Node.AnnotationFilter
-		}
-		if edns.Spec.Source.Node.LabelFilter != nil {
-			config.LabelFilter = *edns.Spec.Source.Node.LabelFilter
-		}
-	}
-
-	// For Service
-	if edns.Spec.Source.Service != nil && edns.Spec.Source.Type.Kind == "Service" {
-		if edns.Spec.Source.Service.LabelFilter != nil {
-			config.LabelFilter = *edns.Spec.Source.Service.LabelFilter
-		}
-		if edns.Spec.Source.Service.Namespace != nil {
-			config.Namespace = *edns.Spec.Source.Service.Namespace
-		}
-		if edns.Spec.Source.Service.AnnotationFilter != nil {
-			config.AnnotationFilter = *edns.Spec.Source.Service.AnnotationFilter
-		}
-		if edns.Spec.Source.Service.FQDNTemplate != nil {
-			config.FQDNTemplate = *edns.Spec.Source.Service.FQDNTemplate
-		}
-		if edns.Spec.Source.Service.CombineFQDNAndAnnotation != nil {
-			config.CombineFQDNAndAnnotation = *edns.Spec.Source.Service.CombineFQDNAndAnnotation
-		}
-		if edns.Spec.Source.Service.Compatibility != nil {
-			config.Compatibility = *edns.Spec.Source.Service.Compatibility
-		}
-		if edns.Spec.Source.Service.PublishInternal != nil {
-			config.PublishInternal = *edns.Spec.Source.Service.PublishInternal
-		}
-		if edns.Spec.Source.Service.PublishHostIP != nil {
-			config.PublishHostIP = *edns.Spec.Source.Service.PublishHostIP
-		}
-		if edns.Spec.Source.Service.AlwaysPublishNotReadyAddresses != nil {
-			config.AlwaysPublishNotReadyAddresses = *edns.Spec.Source.Service.AlwaysPublishNotReadyAddresses
-		}
-		if edns.Spec.Source.Service.ServiceTypeFilter != nil {
-			config.ServiceTypeFilter = edns.Spec.Source.Service.ServiceTypeFilter
-		}
-		if edns.Spec.Source.Service.IgnoreHostnameAnnotation != nil {
-			config.IgnoreHostnameAnnotation = *edns.Spec.Source.Service.IgnoreHostnameAnnotation
-		}
-	}
-
-	// For Ingress
-	if edns.Spec.Source.Ingress != nil && edns.Spec.Source.Type.Kind == "Ingress" {
-		if edns.Spec.Source.Ingress.IgnoreIngressRulesSpec != nil {
-			config.IgnoreIngressRulesSpec = *edns.Spec.Source.Ingress.IgnoreIngressRulesSpec
-		}
-		if edns.Spec.Source.Ingress.IgnoreHostnameAnnotation != nil {
-			config.IgnoreHostnameAnnotation = *edns.Spec.Source.Ingress.IgnoreHostnameAnnotation
-		}
-		if edns.Spec.Source.Ingress.FQDNTemplate != nil {
-			config.FQDNTemplate = *edns.Spec.Source.Ingress.FQDNTemplate
-		}
-		if edns.Spec.Source.Ingress.Namespace != nil {
-			config.Namespace = *edns.Spec.Source.Ingress.Namespace
-		}
-		if edns.Spec.Source.Ingress.AnnotationFilter != nil {
-			config.AnnotationFilter = *edns.Spec.Source.Ingress.AnnotationFilter
-		}
-		if edns.Spec.Source.Ingress.CombineFQDNAndAnnotation != nil {
-			config.CombineFQDNAndAnnotation = *edns.Spec.Source.Ingress.CombineFQDNAndAnnotation
-		}
-		if edns.Spec.Source.Ingress.IgnoreIngressTLSSpec != nil {
-			config.IgnoreIngressTLSSpec = *edns.Spec.Source.Ingress.IgnoreIngressTLSSpec
-		}
-		if edns.Spec.Source.Ingress.LabelFilter != nil {
-			config.LabelFilter = *edns.Spec.Source.Ingress.LabelFilter
-		}
-	}
-
 	// PROVIDER
 	config.Provider = edns.Spec.Provider.String()
 
@@ -510,11 +617,6 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 		}
 	}
 
-	// for azure provide
-	if edns.Spec.Provider == api.ProviderAzure {
-		// hard-code assignment of AzureConfigFile path
-		config.AzureConfigFile = fmt.Sprintf("/tmp/%s-%s-credential", edns.Namespace, edns.Name)
-	}
 	if edns.Spec.Azure != nil {
 		if edns.Spec.Azure.SubscriptionId != nil {
 			config.AzureSubscriptionID = *edns.Spec.Azure.SubscriptionId
@@ -531,6 +633,12 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 		if edns.Spec.Azure.MaxRetriesCount != nil {
 			config.AzureMaxRetriesCount = *edns.Spec.Azure.MaxRetriesCount
 		}
+		if edns.Spec.Azure.ActiveDirectoryAuthorityHost != nil {
+			config.AzureActiveDirectoryAuthorityHost = *edns.Spec.Azure.ActiveDirectoryAuthorityHost
+		}
+		if edns.Spec.Azure.ZoneType != nil && *edns.Spec.Azure.ZoneType == api.AzureZoneTypePrivate && config.Provider == api.ProviderAzure.String() {
+			config.Provider = "azure-private-dns"
+		}
 	}
 
 	// for google dns provider
@@ -552,6 +660,168 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 		}
 	}
 
+	if edns.Spec.RFC2136 != nil {
+		config.RFC2136Host = edns.Spec.RFC2136.Host
+		if edns.Spec.RFC2136.Port != nil {
+			config.RFC2136Port = *edns.Spec.RFC2136.Port
+		}
+		if edns.Spec.RFC2136.Zone != nil {
+			config.RFC2136Zone = edns.Spec.RFC2136.Zone
+		}
+		if edns.Spec.RFC2136.Insecure != nil {
+			config.RFC2136Insecure = *edns.Spec.RFC2136.Insecure
+		}
+		if edns.Spec.RFC2136.TSIGKeyName != nil {
+			config.RFC2136TSIGKeyName = *edns.Spec.RFC2136.TSIGKeyName
+		}
+		if edns.Spec.RFC2136.TSIGSecretAlg != nil {
+			config.RFC2136TSIGSecretAlg = *edns.Spec.RFC2136.TSIGSecretAlg
+		}
+		if edns.Spec.RFC2136.TAXFR != nil {
+			config.RFC2136TAXFR = *edns.Spec.RFC2136.TAXFR
+		}
+		if edns.Spec.RFC2136.MinTTL != nil {
+			config.RFC2136MinTTL = *edns.Spec.RFC2136.MinTTL
+		}
+		if edns.Spec.RFC2136.BatchChangeSize != nil {
+			config.RFC2136BatchChangeSize = *edns.Spec.RFC2136.BatchChangeSize
+		}
+		if edns.Spec.RFC2136.CreatePTR != nil {
+			config.RFC2136CreatePTR = *edns.Spec.RFC2136.CreatePTR
+		}
+		if edns.Spec.RFC2136.LoadBalancingStrategy != nil {
+			config.RFC2136LoadBalancingStrategy = *edns.Spec.RFC2136.LoadBalancingStrategy
+		}
+		if edns.Spec.RFC2136.GSSTSIG != nil {
+			config.RFC2136GSSTSIG = *edns.Spec.RFC2136.GSSTSIG
+		}
+		if edns.Spec.RFC2136.KerberosRealm != nil {
+			config.RFC2136KerberosRealm = *edns.Spec.RFC2136.KerberosRealm
+		}
+		if edns.Spec.RFC2136.UseTLS != nil {
+			config.RFC2136UseTLS = *edns.Spec.RFC2136.UseTLS
+		}
+		if edns.Spec.RFC2136.SkipTLSVerify != nil {
+			config.RFC2136SkipTLSVerify = *edns.Spec.RFC2136.SkipTLSVerify
+		}
+	}
+
+	if edns.Spec.PDNS != nil {
+		config.PDNSServer = edns.Spec.PDNS.Server
+		if edns.Spec.PDNS.ServerID != nil {
+			config.PDNSServerID = *edns.Spec.PDNS.ServerID
+		}
+		if edns.Spec.PDNS.SkipTLSVerify != nil {
+			config.PDNSSkipTLSVerify = *edns.Spec.PDNS.SkipTLSVerify
+		}
+	}
+
+	if edns.Spec.CoreDNS != nil && edns.Spec.CoreDNS.Prefix != nil {
+		config.CoreDNSPrefix = *edns.Spec.CoreDNS.Prefix
+	}
+
+	if edns.Spec.Webhook != nil {
+		if edns.Spec.Webhook.Server != nil {
+			config.WebhookProviderURL = edns.WebhookServerURL()
+		}
+		if edns.Spec.Webhook.URL != nil {
+			config.WebhookProviderURL = *edns.Spec.Webhook.URL
+		}
+		if edns.Spec.Webhook.ReadTimeout != nil {
+			config.WebhookProviderReadTimeout = *edns.Spec.Webhook.ReadTimeout
+		}
+		if edns.Spec.Webhook.WriteTimeout != nil {
+			config.WebhookProviderWriteTimeout = *edns.Spec.Webhook.WriteTimeout
+		}
+	}
+
+	if edns.Spec.Akamai != nil && edns.Spec.Akamai.EdgercSection != nil {
+		config.AkamaiEdgercSection = *edns.Spec.Akamai.EdgercSection
+	}
+
+	if edns.Spec.OCI != nil {
+		if edns.Spec.OCI.AuthMode != nil {
+			config.OCIAuthInstancePrincipal = *edns.Spec.OCI.AuthMode == api.OCIAuthInstancePrincipal
+		}
+		if edns.Spec.OCI.CompartmentOCID != nil {
+			config.OCICompartmentOCID = *edns.Spec.OCI.CompartmentOCID
+		}
+		if edns.Spec.OCI.ZoneScope != nil {
+			config.OCIZoneScope = *edns.Spec.OCI.ZoneScope
+		}
+		if edns.Spec.OCI.ZoneCacheDuration != nil {
+			config.OCIZoneCacheDuration = *edns.Spec.OCI.ZoneCacheDuration
+		}
+	}
+
+	if edns.Spec.DigitalOcean != nil && edns.Spec.DigitalOcean.APIPageSize != nil {
+		config.DigitalOceanAPIPageSize = *edns.Spec.DigitalOcean.APIPageSize
+	}
+
+	if edns.Spec.GoDaddy != nil {
+		if edns.Spec.GoDaddy.TTL != nil {
+			config.GoDaddyTTL = *edns.Spec.GoDaddy.TTL
+		}
+		if edns.Spec.GoDaddy.OTE != nil {
+			config.GoDaddyOTE = *edns.Spec.GoDaddy.OTE
+		}
+	}
+
+	if edns.Spec.Pihole != nil {
+		config.PiholeServer = edns.Spec.Pihole.Server
+		if edns.Spec.Pihole.TLSInsecureSkipVerify != nil {
+			config.PiholeTLSInsecureSkipVerify = *edns.Spec.Pihole.TLSInsecureSkipVerify
+		}
+		if edns.Spec.Pihole.APIVersion != nil {
+			config.PiholeApiVersion = *edns.Spec.Pihole.APIVersion
+		}
+	}
+
+	if edns.Spec.NS1 != nil {
+		if edns.Spec.NS1.Endpoint != nil {
+			config.NS1Endpoint = *edns.Spec.NS1.Endpoint
+		}
+		if edns.Spec.NS1.IgnoreSSL != nil {
+			config.NS1IgnoreSSL = *edns.Spec.NS1.IgnoreSSL
+		}
+		if edns.Spec.NS1.MinTTLSeconds != nil {
+			config.NS1MinTTLSeconds = *edns.Spec.NS1.MinTTLSeconds
+		}
+	}
+
+	if edns.Spec.OVH != nil {
+		if edns.Spec.OVH.Endpoint != nil {
+			config.OVHEndpoint = *edns.Spec.OVH.Endpoint
+		}
+		if edns.Spec.OVH.APIRateLimit != nil {
+			config.OVHApiRateLimit = *edns.Spec.OVH.APIRateLimit
+		}
+		if edns.Spec.OVH.EnableCNAMERelative != nil {
+			config.OVHEnableCNAMERelative = *edns.Spec.OVH.EnableCNAMERelative
+		}
+	}
+
+	if edns.Spec.TransIP != nil {
+		config.TransIPAccountName = edns.Spec.TransIP.AccountName
+	}
+
+	if edns.Spec.Exoscale != nil {
+		if edns.Spec.Exoscale.APIEnvironment != nil {
+			config.ExoscaleAPIEnvironment = *edns.Spec.Exoscale.APIEnvironment
+		}
+		if edns.Spec.Exoscale.APIZone != nil {
+			config.ExoscaleAPIZone = *edns.Spec.Exoscale.APIZone
+		}
+	}
+
+	if edns.Spec.AlibabaCloud != nil && edns.Spec.AlibabaCloud.ZoneType != nil {
+		config.AlibabaCloudZoneType = *edns.Spec.AlibabaCloud.ZoneType
+	}
+
+	if edns.Spec.InMemory != nil {
+		config.InMemoryZones = edns.Spec.InMemory.Zones
+	}
+
 	// POLICY
 
 	if edns.Spec.Policy != nil {
@@ -560,7 +830,27 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 
 	// REGISTRY
 	if edns.Spec.Registry != nil {
-		config.Registry = *edns.Spec.Registry
+		config.Registry = edns.Spec.Registry.Type.String()
+	}
+	// Cloud Map services carry their owner in the service description and cannot hold ownership TXT
+	// records, so the aws-sd provider only works with the aws-sd, noop and dynamodb registries
+	if config.Provider == api.ProviderAWSSD.String() && config.Registry != api.RegistryNoop.String() &&
+		config.Registry != api.RegistryAWSSD.String() && config.Registry != api.RegistryDynamoDB.String() {
+		klog.InfoS("registry cannot be used with AWS Cloud Map, switching to aws-sd", "registry", config.Registry)
+		config.Registry = api.RegistryAWSSD.String()
+	}
+	// Pi-hole only stores A, AAAA and CNAME records, so it cannot keep ownership TXT records
+	if config.Provider == api.ProviderPihole.String() && config.Registry != api.RegistryNoop.String() {
+		klog.InfoS("registry cannot be used with Pi-hole, switching to noop", "registry", config.Registry)
+		config.Registry = api.RegistryNoop.String()
+	}
+	if edns.Spec.Registry != nil && edns.Spec.Registry.DynamoDB != nil {
+		if edns.Spec.Registry.DynamoDB.Table != nil {
+			config.AWSDynamoDBTable = *edns.Spec.Registry.DynamoDB.Table
+		}
+		if edns.Spec.Registry.DynamoDB.Region != nil {
+			config.AWSDynamoDBRegion = *edns.Spec.Registry.DynamoDB.Region
+		}
 	}
 	if edns.Spec.TXTOwnerID != nil {
 		config.TXTOwnerID = *edns.Spec.TXTOwnerID
@@ -578,10 +868,204 @@ func convertEDNSObjectToCfg(edns *api.ExternalDNS) *externaldns.Config {
 	return &config
 }
 
-func createEndpointsSource(ctx context.Context, cfg *externaldns.Config) (source.Source, error) {
-	sourceCfg := source.NewSourceConfig(cfg)
-	sources, err := source.ByNames(ctx, &source.SingletonClientGenerator{
-		KubeConfig:   cfg.KubeConfig,
+// sourceConfig returns a copy of cfg with the per-kind settings of src applied, so every source
+// of an ExternalDNS is built with its own namespace, filters and templates
+func sourceConfig(cfg *externaldns.Config, src api.SourceConfig) (*externaldns.Config, error) {
+	config := *cfg
+
+	// For Node
+	if src.Node != nil && src.Type.Kind == "Node" {
+		config.FQDNTemplate = src.Node.FQDNTemplate
+		if src.Node.AnnotationFilter != nil {
+			config.AnnotationFilter = *src.Node.AnnotationFilter
+		}
+		if src.Node.LabelFilter != nil {
+			config.LabelFilter = *src.Node.LabelFilter
+		}
+	}
+
+	// For Service
+	if src.Service != nil && src.Type.Kind == "Service" {
+		if src.Service.LabelFilter != nil {
+			config.LabelFilter = *src.Service.LabelFilter
+		}
+		if src.Service.Namespace != nil {
+			config.Namespace = *src.Service.Namespace
+		}
+		if src.Service.AnnotationFilter != nil {
+			config.AnnotationFilter = *src.Service.AnnotationFilter
+		}
+		if src.Service.FQDNTemplate != nil {
+			config.FQDNTemplate = *src.Service.FQDNTemplate
+		}
+		if src.Service.CombineFQDNAndAnnotation != nil {
+			config.CombineFQDNAndAnnotation = *src.Service.CombineFQDNAndAnnotation
+		}
+		if src.Service.Compatibility != nil {
+			config.Compatibility = *src.Service.Compatibility
+		}
+		if src.Service.PublishInternal != nil {
+			config.PublishInternal = *src.Service.PublishInternal
+		}
+		if src.Service.PublishHostIP != nil {
+			config.PublishHostIP = *src.Service.PublishHostIP
+		}
+		if src.Service.AlwaysPublishNotReadyAddresses != nil {
+			config.AlwaysPublishNotReadyAddresses = *src.Service.AlwaysPublishNotReadyAddresses
+		}
+		if src.Service.ServiceTypeFilter != nil {
+			config.ServiceTypeFilter = src.Service.ServiceTypeFilter
+		}
+		if src.Service.IgnoreHostnameAnnotation != nil {
+			config.IgnoreHostnameAnnotation = *src.Service.IgnoreHostnameAnnotation
+		}
+	}
+
+	// For Ingress
+	if src.Ingress != nil && src.Type.Kind == "Ingress" {
+		if src.Ingress.IgnoreIngressRulesSpec != nil {
+			config.IgnoreIngressRulesSpec = *src.Ingress.IgnoreIngressRulesSpec
+		}
+		if src.Ingress.IgnoreHostnameAnnotation != nil {
+			config.IgnoreHostnameAnnotation = *src.Ingress.IgnoreHostnameAnnotation
+		}
+		if src.Ingress.FQDNTemplate != nil {
+			config.FQDNTemplate = *src.Ingress.FQDNTemplate
+		}
+		if src.Ingress.Namespace != nil {
+			config.Namespace = *src.Ingress.Namespace
+		}
+		if src.Ingress.AnnotationFilter != nil {
+			config.AnnotationFilter = *src.Ingress.AnnotationFilter
+		}
+		if src.Ingress.CombineFQDNAndAnnotation != nil {
+			config.CombineFQDNAndAnnotation = *src.Ingress.CombineFQDNAndAnnotation
+		}
+		if src.Ingress.IgnoreIngressTLSSpec != nil {
+			config.IgnoreIngressTLSSpec = *src.Ingress.IgnoreIngressTLSSpec
+		}
+		if src.Ingress.LabelFilter != nil {
+			config.LabelFilter = *src.Ingress.LabelFilter
+		}
+	}
+
+	// For Gateway API routes
+	if src.GatewayRoute != nil && isGatewayRouteKind(src.Type.Kind) {
+		if src.GatewayRoute.Namespace != nil {
+			config.Namespace = *src.GatewayRoute.Namespace
+		}
+		if src.GatewayRoute.LabelFilter != nil {
+			config.LabelFilter = *src.GatewayRoute.LabelFilter
+		}
+		if src.GatewayRoute.AnnotationFilter != nil {
+			config.AnnotationFilter = *src.GatewayRoute.AnnotationFilter
+		}
+		if src.GatewayRoute.FQDNTemplate != nil {
+			config.FQDNTemplate = *src.GatewayRoute.FQDNTemplate
+		}
+		if src.GatewayRoute.CombineFQDNAndAnnotation != nil {
+			config.CombineFQDNAndAnnotation = *src.GatewayRoute.CombineFQDNAndAnnotation
+		}
+		if src.GatewayRoute.IgnoreHostnameAnnotation != nil {
+			config.IgnoreHostnameAnnotation = *src.GatewayRoute.IgnoreHostnameAnnotation
+		}
+		if src.GatewayRoute.GatewayName != nil {
+			config.GatewayName = *src.GatewayRoute.GatewayName
+		}
+	}
+
+	// For Istio Gateway and VirtualService
+	if src.Istio != nil && informers.IsIstioKind(src.Type) {
+		if src.Istio.Namespace != nil {
+			config.Namespace = *src.Istio.Namespace
+		}
+		if src.Istio.AnnotationFilter != nil {
+			config.AnnotationFilter = *src.Istio.AnnotationFilter
+		}
+		if src.Istio.LabelFilter != nil {
+			config.LabelFilter = *src.Istio.LabelFilter
+		}
+		if src.Istio.FQDNTemplate != nil {
+			config.FQDNTemplate = *src.Istio.FQDNTemplate
+		}
+		if src.Istio.CombineFQDNAndAnnotation != nil {
+			config.CombineFQDNAndAnnotation = *src.Istio.CombineFQDNAndAnnotation
+		}
+		if src.Istio.IgnoreHostnameAnnotation != nil {
+			config.IgnoreHostnameAnnotation = *src.Istio.IgnoreHostnameAnnotation
+		}
+	}
+
+	// For DNSEndpoint and other custom resources read by the crd source
+	if src.IsCRDSource() {
+		gvk, err := src.CRDSourceGroupVersionKind()
+		if err != nil {
+			return nil, err
+		}
+		config.CRDSourceAPIVersion = gvk.GroupVersion().String()
+		config.CRDSourceKind = gvk.Kind
+
+		if src.CRD != nil {
+			if src.CRD.Namespace != nil {
+				config.Namespace = *src.CRD.Namespace
+			}
+			if src.CRD.AnnotationFilter != nil {
+				config.AnnotationFilter = *src.CRD.AnnotationFilter
+			}
+			if src.CRD.LabelFilter != nil {
+				config.LabelFilter = *src.CRD.LabelFilter
+			}
+		}
+	}
+
+	return &config, nil
+}
+
+// gatewayRouteSources maps the Gateway API route kinds to the name of the external-dns source reading them
+var gatewayRouteSources = map[string]string{
+	"HTTPRoute": types.GatewayHttpRoute,
+	"GRPCRoute": types.GatewayGrpcRoute,
+	"TLSRoute":  types.GatewayTlsRoute,
+	"TCPRoute":  types.GatewayTcpRoute,
+	"UDPRoute":  types.GatewayUdpRoute,
+}
+
+// istioSources maps the Istio kinds to the name of the external-dns source reading them
+var istioSources = map[string]string{
+	"Gateway":        types.IstioGateway,
+	"VirtualService": types.IstioVirtualService,
+}
+
+func isGatewayRouteKind(kind string) bool {
+	_, found := gatewayRouteSources[kind]
+	return found
+}
+
+// sourceName returns the external-dns source name for the given source
+func sourceName(src api.SourceConfig) string {
+	if src.IsCRDSource() {
+		return types.CRD
+	}
+	t := src.Type
+	if informers.IsIstioKind(t) {
+		return istioSources[t.Kind]
+	}
+	if name, found := gatewayRouteSources[t.Kind]; found {
+		return name
+	}
+	return strings.ToLower(t.Kind)
+}
+
+func createEndpointsSource(ctx context.Context, cfg *externaldns.Config, srcs []api.SourceConfig) (source.Source, error) {
+	kubeConfig := cfg.KubeConfig
+	if kubeConfig == "" {
+		// like the operator itself, the sources read the cluster of KUBECONFIG when running out of a cluster
+		if paths := filepath.SplitList(os.Getenv(clientcmd.RecommendedConfigPathEnvVar)); len(paths) > 0 {
+			kubeConfig = paths[0]
+		}
+	}
+	clientGenerator := &source.SingletonClientGenerator{
+		KubeConfig:   kubeConfig,
 		APIServerURL: cfg.APIServerURL,
 		RequestTimeout: func() time.Duration {
 			if cfg.UpdateEvents {
@@ -589,10 +1073,31 @@ func createEndpointsSource(ctx context.Context, cfg *externaldns.Config) (source
 			}
 			return cfg.RequestTimeout
 		}(),
-	}, cfg.Sources, sourceCfg)
-	if err != nil {
-		return nil, err
 	}
+	return buildEndpointsSource(ctx, cfg, srcs, clientGenerator)
+}
+
+// buildEndpointsSource builds every source with its own config and merges their endpoints
+func buildEndpointsSource(ctx context.Context, cfg *externaldns.Config, srcs []api.SourceConfig, clientGenerator source.ClientGenerator) (source.Source, error) {
+	if len(srcs) == 0 {
+		return nil, errors.New("no source specified, set either source or sources")
+	}
+	sources := make([]source.Source, 0, len(srcs))
+	for _, src := range srcs {
+		srcCfg, err := sourceConfig(cfg, src)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source %s: %w", sourceName(src), err)
+		}
+		if _, err := labels.Parse(srcCfg.LabelFilter); err != nil {
+			return nil, fmt.Errorf("invalid label filter of source %s: %w", sourceName(src), err)
+		}
+		s, err := source.BuildWithConfig(ctx, sourceName(src), clientGenerator, source.NewSourceConfig(srcCfg))
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, s)
+	}
+	// endpoints of all the sources are merged and deduplicated before planning
 	opts := wrappers.NewConfig(
 		wrappers.WithDefaultTargets(cfg.DefaultTargets),
 		wrappers.WithForceDefaultTargets(cfg.ForceDefaultTargets),
@@ -603,10 +1108,26 @@ func createEndpointsSource(ctx context.Context, cfg *externaldns.Config) (source
 	return wrappers.WrapSources(sources, opts)
 }
 
+// newProvider returns the provider of the ExternalDNS. The inmemory provider keeps its records across
+// reconciles, even when the other components are built again.
+func newProvider(
+	ctx context.Context,
+	edns *api.ExternalDNS,
+	cfg *externaldns.Config,
+	domainFilter *endpoint.DomainFilter,
+	cred *credentials.Credential,
+) (provider.Provider, error) {
+	if cfg.Provider == api.ProviderInMemory.String() {
+		return inMemoryProvider(edns, cfg, domainFilter), nil
+	}
+	return buildProvider(ctx, cfg, domainFilter, cred)
+}
+
 func buildProvider(
 	ctx context.Context,
 	cfg *externaldns.Config,
 	domainFilter *endpoint.DomainFilter,
+	cred *credentials.Credential,
 ) (provider.Provider, error) {
 	var p provider.Provider
 	var err error
@@ -618,26 +1139,15 @@ func buildProvider(
 
 	switch cfg.Provider {
 	case "akamai":
-		p, err = akamai.NewAkamaiProvider(
-			akamai.AkamaiConfig{
-				DomainFilter:          domainFilter,
-				ZoneIDFilter:          zoneIDFilter,
-				ServiceConsumerDomain: cfg.AkamaiServiceConsumerDomain,
-				ClientToken:           cfg.AkamaiClientToken,
-				ClientSecret:          cfg.AkamaiClientSecret,
-				AccessToken:           cfg.AkamaiAccessToken,
-				EdgercPath:            cfg.AkamaiEdgercPath,
-				EdgercSection:         cfg.AkamaiEdgercSection,
-				DryRun:                cfg.DryRun,
-			}, nil)
+		p, err = newAkamaiProvider(cfg, domainFilter, zoneIDFilter, cred)
 	case "alibabacloud":
 		p, err = alibabacloud.NewAlibabaCloudProvider(cfg.AlibabaCloudConfigFile, domainFilter, zoneIDFilter, cfg.AlibabaCloudZoneType, cfg.DryRun)
 	case "aws":
-		configs := aws.CreateV2Configs(cfg)
-		clients := make(map[string]aws.Route53API, len(configs))
-		for profile, config := range configs {
-			clients[profile] = route53.NewFromConfig(config)
+		awsConfig, cfgErr := createAWSConfig(ctx, cfg, cred)
+		if cfgErr != nil {
+			return nil, cfgErr
 		}
+		clients := map[string]aws.Route53API{"default": route53.NewFromConfig(awsConfig)}
 
 		p, err = aws.NewAWSProvider(
 			aws.AWSConfig{
@@ -658,49 +1168,35 @@ func buildProvider(
 			clients,
 		)
 	case "aws-sd":
-		// Check that only compatible Registry is used with AWS-SD
-		if cfg.Registry != "noop" && cfg.Registry != "aws-sd" {
-			log.Infof("Registry \"%s\" cannot be used with AWS Cloud Map. Switching to \"aws-sd\".", cfg.Registry)
-			cfg.Registry = "aws-sd"
+		awsConfig, cfgErr := createAWSConfig(ctx, cfg, cred)
+		if cfgErr != nil {
+			return nil, cfgErr
 		}
-		p, err = awssd.NewAWSSDProvider(domainFilter, cfg.AWSZoneType, cfg.DryRun, cfg.AWSSDServiceCleanup, cfg.TXTOwnerID, cfg.AWSSDCreateTag, sd.NewFromConfig(aws.CreateDefaultV2Config(cfg)))
+		p, err = awssd.NewAWSSDProvider(domainFilter, cfg.AWSZoneType, cfg.DryRun, cfg.AWSSDServiceCleanup, cfg.TXTOwnerID, cfg.AWSSDCreateTag, sd.NewFromConfig(awsConfig))
 	case "azure-dns", "azure":
-		p, err = azure.NewAzureProvider(cfg.AzureConfigFile, domainFilter, zoneNameFilter, zoneIDFilter, cfg.AzureSubscriptionID, cfg.AzureResourceGroup, cfg.AzureUserAssignedIdentityClientID, cfg.AzureActiveDirectoryAuthorityHost, cfg.AzureZonesCacheDuration, cfg.AzureMaxRetriesCount, cfg.DryRun)
+		p, err = azure.NewAzureProvider(azureConfigFile(cfg, cred), domainFilter, zoneNameFilter, zoneIDFilter, cfg.AzureSubscriptionID, cfg.AzureResourceGroup, cfg.AzureUserAssignedIdentityClientID, cfg.AzureActiveDirectoryAuthorityHost, cfg.AzureZonesCacheDuration, cfg.AzureMaxRetriesCount, cfg.DryRun)
 	case "azure-private-dns":
-		p, err = azure.NewAzurePrivateDNSProvider(cfg.AzureConfigFile, domainFilter, zoneNameFilter, zoneIDFilter, cfg.AzureSubscriptionID, cfg.AzureResourceGroup, cfg.AzureUserAssignedIdentityClientID, cfg.AzureActiveDirectoryAuthorityHost, cfg.AzureZonesCacheDuration, cfg.AzureMaxRetriesCount, cfg.DryRun)
+		// private zones are listed per resource group of the subscription, both must be set on the ExternalDNS
+		if cfg.AzureResourceGroup == "" || cfg.AzureSubscriptionID == "" {
+			return nil, errors.New("resourceGroup and subscriptionId are required for azure private dns zones")
+		}
+		p, err = azure.NewAzurePrivateDNSProvider(azureConfigFile(cfg, cred), domainFilter, zoneNameFilter, zoneIDFilter, cfg.AzureSubscriptionID, cfg.AzureResourceGroup, cfg.AzureUserAssignedIdentityClientID, cfg.AzureActiveDirectoryAuthorityHost, cfg.AzureZonesCacheDuration, cfg.AzureMaxRetriesCount, cfg.DryRun)
 	case "civo":
-		p, err = civo.NewCivoProvider(domainFilter, cfg.DryRun)
+		p, err = newCivoProvider(cfg, cred)
 	case "cloudflare":
-		p, err = cloudflare.NewCloudFlareProvider(
-			domainFilter,
-			zoneIDFilter,
-			cfg.CloudflareProxied,
-			cfg.DryRun,
-			cloudflare.RegionalServicesConfig{
-				Enabled:   cfg.CloudflareRegionalServices,
-				RegionKey: cfg.CloudflareRegionKey,
-			},
-			cloudflare.CustomHostnamesConfig{
-				Enabled:              cfg.CloudflareCustomHostnames,
-				MinTLSVersion:        cfg.CloudflareCustomHostnamesMinTLSVersion,
-				CertificateAuthority: cfg.CloudflareCustomHostnamesCertificateAuthority,
-			},
-			cloudflare.DNSRecordsConfig{
-				PerPage: cfg.CloudflareDNSRecordsPerPage,
-				Comment: cfg.CloudflareDNSRecordsComment,
-			})
+		p, err = newCloudflareProvider(cfg, domainFilter, zoneIDFilter, cred)
 	case "google":
-		p, err = google.NewGoogleProvider(ctx, cfg.GoogleProject, domainFilter, zoneIDFilter, cfg.GoogleBatchChangeSize, cfg.GoogleBatchChangeInterval, cfg.GoogleZoneVisibility, cfg.DryRun)
+		p, err = newGoogleProvider(ctx, cfg, domainFilter, zoneIDFilter, cred)
 	case "digitalocean":
-		p, err = digitalocean.NewDigitalOceanProvider(ctx, domainFilter, cfg.DryRun, cfg.DigitalOceanAPIPageSize)
+		p, err = newDigitalOceanProvider(ctx, cfg, domainFilter, cred)
 	case "ovh":
-		p, err = ovh.NewOVHProvider(ctx, domainFilter, cfg.OVHEndpoint, cfg.OVHApiRateLimit, cfg.OVHEnableCNAMERelative, cfg.DryRun)
+		p, err = newOVHProvider(cfg, domainFilter, cred)
 	case "linode":
-		p, err = linode.NewLinodeProvider(domainFilter, cfg.DryRun)
+		p, err = newLinodeProvider(cfg, domainFilter, cred)
 	case "dnsimple":
-		p, err = dnsimple.NewDnsimpleProvider(domainFilter, zoneIDFilter, cfg.DryRun)
+		p, err = newDNSimpleProvider(ctx, cfg, domainFilter, zoneIDFilter, cred)
 	case "coredns", "skydns":
-		p, err = coredns.NewCoreDNSProvider(domainFilter, cfg.CoreDNSPrefix, cfg.DryRun)
+		p, err = newCoreDNSProvider(cfg, domainFilter, cred)
 	case "exoscale":
 		p, err = exoscale.NewExoscaleProvider(
 			cfg.ExoscaleAPIEnvironment,
@@ -742,7 +1238,10 @@ func buildProvider(
 				config = &oci.OCIConfig{Auth: authConfig, CompartmentID: cfg.OCICompartmentOCID}
 			}
 		} else {
-			config, err = oci.LoadOCIConfig(cfg.OCIConfigFile)
+			config, err = oci.LoadOCIConfig(ociConfigFile(cfg, cred))
+			if err == nil && cfg.OCICompartmentOCID != "" {
+				config.CompartmentID = cfg.OCICompartmentOCID
+			}
 		}
 		if err == nil {
 			config.ZoneCacheDuration = cfg.OCIZoneCacheDuration
@@ -758,24 +1257,15 @@ func buildProvider(
 		}
 		p, err = rfc2136.NewRfc2136Provider(cfg.RFC2136Host, cfg.RFC2136Port, cfg.RFC2136Zone, cfg.RFC2136Insecure, cfg.RFC2136TSIGKeyName, cfg.RFC2136TSIGSecret, cfg.RFC2136TSIGSecretAlg, cfg.RFC2136TAXFR, domainFilter, cfg.DryRun, cfg.RFC2136MinTTL, cfg.RFC2136CreatePTR, cfg.RFC2136GSSTSIG, cfg.RFC2136KerberosUsername, cfg.RFC2136KerberosPassword, cfg.RFC2136KerberosRealm, cfg.RFC2136BatchChangeSize, tlsConfig, cfg.RFC2136LoadBalancingStrategy, nil)
 	case "ns1":
-		p, err = ns1.NewNS1Provider(
-			ns1.NS1Config{
-				DomainFilter:  domainFilter,
-				ZoneIDFilter:  zoneIDFilter,
-				NS1Endpoint:   cfg.NS1Endpoint,
-				NS1IgnoreSSL:  cfg.NS1IgnoreSSL,
-				DryRun:        cfg.DryRun,
-				MinTTLSeconds: cfg.NS1MinTTLSeconds,
-			},
-		)
+		p, err = newNS1Provider(cfg, domainFilter, zoneIDFilter, cred)
 	case "transip":
 		p, err = transip.NewTransIPProvider(cfg.TransIPAccountName, cfg.TransIPPrivateKeyFile, domainFilter, cfg.DryRun)
 	case "scaleway":
-		p, err = scaleway.NewScalewayProvider(ctx, domainFilter, cfg.DryRun)
+		p, err = newScalewayProvider(cfg, domainFilter, cred)
 	case "godaddy":
 		p, err = godaddy.NewGoDaddyProvider(ctx, domainFilter, cfg.GoDaddyTTL, cfg.GoDaddyAPIKey, cfg.GoDaddySecretKey, cfg.GoDaddyOTE, cfg.DryRun)
 	case "gandi":
-		p, err = gandi.NewGandiProvider(ctx, domainFilter, cfg.DryRun)
+		p, err = newGandiProvider(cfg, domainFilter, cred)
 	case "pihole":
 		p, err = pihole.NewPiholeProvider(
 			pihole.PiholeConfig{
@@ -790,7 +1280,7 @@ func buildProvider(
 	case "plural":
 		p, err = plural.NewPluralProvider(cfg.PluralCluster, cfg.PluralProvider)
 	case "webhook":
-		p, err = webhook.NewWebhookProvider(cfg.WebhookProviderURL)
+		p, err = newWebhookProvider(ctx, cfg, cred)
 	default:
 		err = fmt.Errorf("unknown dns provider: %s", cfg.Provider)
 	}
@@ -803,20 +1293,12 @@ func buildProvider(
 	return p, err
 }
 
-func createRegistry(cfg *externaldns.Config, p provider.Provider) (registry.Registry, error) {
+func createRegistry(ctx context.Context, edns *api.ExternalDNS, cfg *externaldns.Config, p provider.Provider, cred *credentials.Credential) (registry.Registry, error) {
 	var r registry.Registry
 	var err error
 	switch cfg.Registry {
 	case "dynamodb":
-		var dynamodbOpts []func(*dynamodb.Options)
-		if cfg.AWSDynamoDBRegion != "" {
-			dynamodbOpts = []func(*dynamodb.Options){
-				func(opts *dynamodb.Options) {
-					opts.Region = cfg.AWSDynamoDBRegion
-				},
-			}
-		}
-		r, err = registry.NewDynamoDBRegistry(p, cfg.TXTOwnerID, dynamodb.NewFromConfig(aws.CreateDefaultV2Config(cfg), dynamodbOpts...), cfg.AWSDynamoDBTable, cfg.TXTPrefix, cfg.TXTSuffix, cfg.TXTWildcardReplacement, cfg.ManagedDNSRecordTypes, cfg.ExcludeDNSRecordTypes, []byte(cfg.TXTEncryptAESKey), cfg.TXTCacheInterval)
+		r, err = newDynamoDBRegistry(ctx, dynamoDBSpec(edns), cfg, p, cred)
 	case "noop":
 		r, err = registry.NewNoopRegistry(p)
 	case "txt":
@@ -830,6 +1312,15 @@ func createRegistry(cfg *externaldns.Config, p provider.Provider) (registry.Regi
 }
 
 // This function configures the logger format and level based on the provided configuration.
+// Init sets the package-level state of external-dns, the annotation prefix read by the sources and the logger of
+// the providers. It is shared by every ExternalDNS and read by the informers of the cached sources, so it is set
+// once before the controller starts and never by a reconcile.
+func Init() error {
+	cfg := newDefaultConfig()
+	annotations.SetAnnotationPrefix(cfg.AnnotationPrefix)
+	return configureLogger(&cfg)
+}
+
 func configureLogger(cfg *externaldns.Config) error {
 	if cfg.LogFormat == "json" {
 		log.SetFormatter(&log.JSONFormatter{})
@@ -0,0 +1,51 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+)
+
+// TestSyncInterval checks the interval the controller requeues an ExternalDNS after
+func TestSyncInterval(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want time.Duration
+	}{
+		{name: "default", spec: `{"provider":"inmemory"}`, want: time.Minute},
+		{name: "duration", spec: `{"provider":"inmemory","interval":"5m"}`, want: 5 * time.Minute},
+		{name: "compound duration", spec: `{"provider":"inmemory","interval":"1h30m"}`, want: 90 * time.Minute},
+		{name: "disabled", spec: `{"provider":"inmemory","interval":"0s"}`, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edns := &api.ExternalDNS{}
+			if err := json.Unmarshal([]byte(tc.spec), &edns.Spec); err != nil {
+				t.Fatalf("failed to decode the spec: %v", err)
+			}
+			if got := SyncInterval(edns); got != tc.want {
+				t.Fatalf("SyncInterval() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
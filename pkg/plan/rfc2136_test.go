@@ -0,0 +1,149 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"github.com/miekg/dns"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns/validation"
+)
+
+const (
+	testZone    = "example.com."
+	testKeyName = "external-dns."
+)
+
+var testTSIGSecret = base64.StdEncoding.EncodeToString([]byte("rfc2136-test-secret"))
+
+// startDNSServer serves an AXFR of testZone, only to transfers signed with testTSIGSecret
+func startDNSServer(t *testing.T) (string, int) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := dns.NewServeMux()
+	mux.HandleFunc(testZone, func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+		if req.IsTsig() == nil || w.TsigStatus() != nil {
+			m.Rcode = dns.RcodeRefused
+			_ = w.WriteMsg(m)
+			return
+		}
+
+		soa, _ := dns.NewRR(testZone + " 300 IN SOA ns.example.com. admin.example.com. 1 60 60 60 60")
+		a, _ := dns.NewRR("www." + testZone + " 300 IN A 192.0.2.10")
+		m.Answer = []dns.RR{soa, a, soa}
+		m.SetTsig(testKeyName, dns.HmacSHA256, 300, int64(req.IsTsig().TimeSigned))
+		_ = w.WriteMsg(m)
+	})
+
+	server := &dns.Server{
+		Listener:   listener,
+		Handler:    mux,
+		TsigSecret: map[string]string{testKeyName: testTSIGSecret},
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p
+}
+
+func rfc2136ExternalDNS(host string, port int) *api.ExternalDNS {
+	return &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderRFC2136,
+			DomainFilter: []string{"example.com"},
+			RFC2136: &api.RFC2136Provider{
+				Host:          []string{host},
+				Port:          ptr.To(port),
+				Zone:          []string{testZone},
+				TSIGKeyName:   ptr.To(testKeyName),
+				TSIGSecretAlg: ptr.To("hmac-sha256"),
+			},
+		},
+	}
+}
+
+func TestRFC2136RecordsWithTSIGCredential(t *testing.T) {
+	host, port := startDNSServer(t)
+	edns := rfc2136ExternalDNS(host, port)
+
+	tests := []struct {
+		name    string
+		secret  string
+		records int
+	}{
+		{name: "secret from credential signs the transfer", secret: testTSIGSecret, records: 1},
+		{name: "wrong secret is refused", secret: base64.StdEncoding.EncodeToString([]byte("wrong")), records: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := convertEDNSObjectToCfg(edns)
+			applyCredential(cfg, &credentials.Credential{RFC2136: &credentials.RFC2136Credential{TSIGSecret: tc.secret}})
+			if err := validation.ValidateConfig(cfg); err != nil {
+				t.Fatalf("config validation failed: %v", err)
+			}
+
+			pvdr, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), nil)
+			if err != nil {
+				t.Fatalf("failed to build provider: %v", err)
+			}
+			records, err := pvdr.Records(context.Background())
+			if err != nil {
+				t.Fatalf("failed to list records: %v", err)
+			}
+			if len(records) != tc.records {
+				t.Fatalf("got %d records, want %d: %v", len(records), tc.records, records)
+			}
+		})
+	}
+}
+
+func TestRFC2136GSSTSIGNeedsKerberosCredential(t *testing.T) {
+	edns := rfc2136ExternalDNS("127.0.0.1", 53)
+	edns.Spec.RFC2136.GSSTSIG = ptr.To(true)
+	edns.Spec.RFC2136.KerberosRealm = ptr.To("EXAMPLE.COM")
+
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, &credentials.Credential{RFC2136: &credentials.RFC2136Credential{}})
+	if err := validation.ValidateConfig(cfg); err == nil {
+		t.Fatal("expected validation to fail without kerberos credentials")
+	}
+
+	cfg = convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, &credentials.Credential{RFC2136: &credentials.RFC2136Credential{KerberosUsername: "user", KerberosPassword: "pass"}})
+	if err := validation.ValidateConfig(cfg); err != nil {
+		t.Fatalf("config validation failed: %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+
+	"gomodules.xyz/sets"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/external-dns/source"
+	"sigs.k8s.io/external-dns/source/annotations"
+	"sigs.k8s.io/external-dns/source/types"
+)
+
+func TestSourceName(t *testing.T) {
+	for _, tc := range []struct {
+		typ  api.TypeInfo
+		want string
+	}{
+		{api.TypeInfo{Version: "v1", Kind: "Service"}, "service"},
+		{api.TypeInfo{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"}, "ingress"},
+		{api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}, types.GatewayHttpRoute},
+		{api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "GRPCRoute"}, types.GatewayGrpcRoute},
+		{api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"}, types.GatewayTlsRoute},
+		{api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TCPRoute"}, types.GatewayTcpRoute},
+		{api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "UDPRoute"}, types.GatewayUdpRoute},
+		{api.TypeInfo{Group: "networking.istio.io", Version: "v1", Kind: "Gateway"}, types.IstioGateway},
+		{api.TypeInfo{Group: "networking.istio.io", Version: "v1", Kind: "VirtualService"}, types.IstioVirtualService},
+	} {
+		if got := sourceName(api.SourceConfig{Type: tc.typ}); got != tc.want {
+			t.Errorf("sourceName(%s) = %q, want %q", tc.typ.Kind, got, tc.want)
+		}
+	}
+}
+
+func TestGatewayRouteSourceConfig(t *testing.T) {
+	cfg := newDefaultConfig()
+	src := api.SourceConfig{
+		Type: api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"},
+		GatewayRoute: &api.GatewayRouteConfig{
+			Namespace:   ptr.To("web"),
+			LabelFilter: ptr.To("team=web"),
+			GatewayName: ptr.To("public"),
+		},
+	}
+
+	got, err := sourceConfig(&cfg, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Namespace != "web" || got.LabelFilter != "team=web" || got.GatewayName != "public" {
+		t.Fatalf("route settings not applied: namespace %q, label filter %q, gateway name %q", got.Namespace, got.LabelFilter, got.GatewayName)
+	}
+
+	// the route settings only apply to route kinds
+	src.Type = api.TypeInfo{Version: "v1", Kind: "Service"}
+	if got, _ := sourceConfig(&cfg, src); got.GatewayName != "" || got.Namespace != cfg.Namespace {
+		t.Fatalf("route settings applied to a Service source: namespace %q, gateway name %q", got.Namespace, got.GatewayName)
+	}
+}
+
+func TestIstioSourceConfig(t *testing.T) {
+	cfg := newDefaultConfig()
+	src := api.SourceConfig{
+		Type: api.TypeInfo{Group: "networking.istio.io", Version: "v1", Kind: "VirtualService"},
+		Istio: &api.IstioConfig{
+			Namespace:        ptr.To("mesh"),
+			AnnotationFilter: ptr.To("external-dns=true"),
+			LabelFilter:      ptr.To("team=mesh"),
+		},
+	}
+
+	got, err := sourceConfig(&cfg, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Namespace != "mesh" || got.AnnotationFilter != "external-dns=true" || got.LabelFilter != "team=mesh" {
+		t.Fatalf("istio settings not applied: namespace %q, annotation filter %q, label filter %q", got.Namespace, got.AnnotationFilter, got.LabelFilter)
+	}
+
+	// the Gateway kind of the Gateway API is not an Istio kind
+	src.Type = api.TypeInfo{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"}
+	if got, _ := sourceConfig(&cfg, src); got.LabelFilter != cfg.LabelFilter || got.Namespace != cfg.Namespace {
+		t.Fatalf("istio settings applied to a Gateway API source: namespace %q, label filter %q", got.Namespace, got.LabelFilter)
+	}
+}
+
+func TestCRDSourceConfig(t *testing.T) {
+	cfg := newDefaultConfig()
+	src := api.SourceConfig{
+		Type: api.TypeInfo{Group: "externaldns.k8s.io", Version: "v1alpha1", Kind: "DNSEndpoint"},
+		CRD: &api.CRDConfig{
+			APIVersion: ptr.To("example.com/v1"),
+			Kind:       ptr.To("Endpoint"),
+			Namespace:  ptr.To("demo"),
+		},
+	}
+
+	got, err := sourceConfig(&cfg, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.CRDSourceAPIVersion != "example.com/v1" || got.CRDSourceKind != "Endpoint" || got.Namespace != "demo" {
+		t.Fatalf("crd settings not applied: apiVersion %q, kind %q, namespace %q", got.CRDSourceAPIVersion, got.CRDSourceKind, got.Namespace)
+	}
+	if name := sourceName(src); name != types.CRD {
+		t.Fatalf("sourceName() = %q, want %q", name, types.CRD)
+	}
+
+	src.CRD.APIVersion = ptr.To("example.com/v1/extra")
+	if _, err := sourceConfig(&cfg, src); err == nil {
+		t.Fatal("expected an error for an invalid crd apiVersion")
+	}
+}
+
+// fakeClientGenerator serves the sources from a fake clientset
+type fakeClientGenerator struct {
+	source.ClientGenerator
+	kubeClient kubernetes.Interface
+}
+
+func (g *fakeClientGenerator) KubeClient() (kubernetes.Interface, error) {
+	return g.kubeClient, nil
+}
+
+func newLoadBalancer(namespace, name, team, hostname, ip string) *core.Service {
+	return &core.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      map[string]string{"team": team},
+			Annotations: map[string]string{"external-dns.alpha.kubernetes.io/hostname": hostname},
+		},
+		Spec: core.ServiceSpec{Type: core.ServiceTypeLoadBalancer},
+		Status: core.ServiceStatus{
+			LoadBalancer: core.LoadBalancerStatus{Ingress: []core.LoadBalancerIngress{{IP: ip}}},
+		},
+	}
+}
+
+func TestBuildEndpointsSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kubeClient := fake.NewClientset(
+		newLoadBalancer("a", "web", "a", "web-a.example.com", "192.0.2.1"),
+		newLoadBalancer("a", "api", "b", "api-a.example.com", "192.0.2.2"),
+		newLoadBalancer("b", "web", "b", "web-b.example.com", "192.0.2.3"),
+	)
+	cfg := newDefaultConfig()
+	annotations.SetAnnotationPrefix(cfg.AnnotationPrefix)
+
+	// every source keeps its own namespace and label filter
+	srcs := []api.SourceConfig{
+		{
+			Type:    api.TypeInfo{Version: "v1", Kind: "Service"},
+			Service: &api.ServiceConfig{Namespace: ptr.To("a"), LabelFilter: ptr.To("team=a")},
+		},
+		{
+			Type:    api.TypeInfo{Version: "v1", Kind: "Service"},
+			Service: &api.ServiceConfig{Namespace: ptr.To("b"), LabelFilter: ptr.To("team=b")},
+		},
+	}
+	s, err := buildEndpointsSource(ctx, &cfg, srcs, &fakeClientGenerator{kubeClient: kubeClient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoints, err := s.Endpoints(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := sets.NewString()
+	for _, ep := range endpoints {
+		got.Insert(ep.DNSName)
+	}
+	if want := sets.NewString("web-a.example.com", "web-b.example.com"); !got.Equal(want) {
+		t.Fatalf("endpoints of %v, want %v", got.List(), want.List())
+	}
+
+	if _, err := buildEndpointsSource(ctx, &cfg, nil, &fakeClientGenerator{kubeClient: kubeClient}); err == nil {
+		t.Fatal("expected an error without sources")
+	}
+
+	srcs[1].Service.LabelFilter = ptr.To("team in (b")
+	if _, err := buildEndpointsSource(ctx, &cfg, srcs, &fakeClientGenerator{kubeClient: kubeClient}); err == nil {
+		t.Fatal("expected an error for an invalid label filter of the second source")
+	}
+}
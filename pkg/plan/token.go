@@ -0,0 +1,251 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+	"kubeops.dev/external-dns-operator/pkg/dnsimple"
+	"kubeops.dev/external-dns-operator/pkg/ns1"
+	"kubeops.dev/external-dns-operator/pkg/scaleway"
+
+	"github.com/civo/civogo"
+	"github.com/digitalocean/godo"
+	gandiclient "github.com/go-gandi/go-gandi"
+	gandiconfig "github.com/go-gandi/go-gandi/config"
+	"github.com/go-gandi/go-gandi/domain"
+	"github.com/linode/linodego"
+	"golang.org/x/oauth2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/provider/civo"
+	"sigs.k8s.io/external-dns/provider/digitalocean"
+	"sigs.k8s.io/external-dns/provider/gandi"
+	"sigs.k8s.io/external-dns/provider/linode"
+)
+
+// The upstream constructors of the providers below only read their token from the environment. Their clients
+// are exported fields, so they are built from the token credential instead, the way the constructors build them.
+// The domain filter of these providers is unexported, it is applied by the client listing the zones. The clients of
+// dnsimple, ns1 and scaleway are unexported, those providers are forked and take the credential in their config.
+
+// providerToken returns the token credential of the provider
+func providerToken(cfg *externaldns.Config, cred *credentials.Credential) (*credentials.TokenCredential, error) {
+	if cred == nil || cred.Token == nil || cred.Token.Token == "" {
+		return nil, fmt.Errorf("no token found for %s provider", cfg.Provider)
+	}
+	return cred.Token, nil
+}
+
+func newDigitalOceanProvider(ctx context.Context, cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token.Token}))
+	client, err := godo.New(oauthClient, godo.SetUserAgent(externaldns.UserAgent()))
+	if err != nil {
+		return nil, err
+	}
+	return &digitalocean.DigitalOceanProvider{
+		Client: digitalOceanDomains{DomainsService: client.Domains, domainFilter: domainFilter, pageSize: cfg.DigitalOceanAPIPageSize},
+		DryRun: cfg.DryRun,
+	}, nil
+}
+
+// digitalOceanDomains lists the domains matching the domain filter, with the configured page size
+type digitalOceanDomains struct {
+	godo.DomainsService
+	domainFilter *endpoint.DomainFilter
+	pageSize     int
+}
+
+func (d digitalOceanDomains) List(ctx context.Context, opt *godo.ListOptions) ([]godo.Domain, *godo.Response, error) {
+	domains, resp, err := d.DomainsService.List(ctx, d.withPageSize(opt))
+	if err != nil {
+		return nil, resp, err
+	}
+	filtered := make([]godo.Domain, 0, len(domains))
+	for _, zone := range domains {
+		if d.domainFilter.Match(zone.Name) {
+			filtered = append(filtered, zone)
+		}
+	}
+	return filtered, resp, nil
+}
+
+func (d digitalOceanDomains) Records(ctx context.Context, name string, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	return d.DomainsService.Records(ctx, name, d.withPageSize(opt))
+}
+
+func (d digitalOceanDomains) withPageSize(opt *godo.ListOptions) *godo.ListOptions {
+	if opt != nil && opt.PerPage == 0 {
+		opt.PerPage = d.pageSize
+	}
+	return opt
+}
+
+func newLinodeProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	client := linodego.NewClient(&http.Client{
+		Transport: &oauth2.Transport{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token.Token})},
+	})
+	client.SetUserAgent(fmt.Sprintf("%s linodego/%s", externaldns.UserAgent(), linodego.Version))
+	if token.URL != "" {
+		client.SetBaseURL(token.URL)
+	}
+	return &linode.LinodeProvider{
+		Client: linodeDomains{LinodeDomainClient: &client, domainFilter: domainFilter},
+		DryRun: cfg.DryRun,
+	}, nil
+}
+
+// linodeDomains lists the domains matching the domain filter
+type linodeDomains struct {
+	linode.LinodeDomainClient
+	domainFilter *endpoint.DomainFilter
+}
+
+func (d linodeDomains) ListDomains(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Domain, error) {
+	domains, err := d.LinodeDomainClient.ListDomains(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]linodego.Domain, 0, len(domains))
+	for _, zone := range domains {
+		if d.domainFilter.Match(zone.Domain) {
+			filtered = append(filtered, zone)
+		}
+	}
+	return filtered, nil
+}
+
+func newGandiProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	config := gandiconfig.Config{
+		PersonalAccessToken: token.Token,
+		SharingID:           token.SharingID,
+		// dry-run doesn't work but it won't hurt passing the flag
+		DryRun: cfg.DryRun,
+	}
+	return &gandi.GandiProvider{
+		LiveDNSClient: gandi.NewLiveDNSClient(gandiclient.NewLiveDNSClient(config)),
+		DomainClient:  gandiDomains{DomainClientAdapter: gandi.NewDomainClient(gandiclient.NewDomainClient(config)), domainFilter: domainFilter},
+		DryRun:        cfg.DryRun,
+	}, nil
+}
+
+// gandiDomains lists the domains matching the domain filter
+type gandiDomains struct {
+	gandi.DomainClientAdapter
+	domainFilter *endpoint.DomainFilter
+}
+
+func (d gandiDomains) ListDomains() ([]domain.ListResponse, error) {
+	domains, err := d.DomainClientAdapter.ListDomains()
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]domain.ListResponse, 0, len(domains))
+	for _, zone := range domains {
+		if d.domainFilter.Match(zone.FQDN) {
+			filtered = append(filtered, zone)
+		}
+	}
+	return filtered, nil
+}
+
+// newCivoProvider builds the civo provider with the token of the credential. Its client is a struct, so the zones
+// cannot be filtered while listing them; the plan still only changes the records matching the domain filter.
+func newCivoProvider(cfg *externaldns.Config, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	// the DNS API is global, the region of the client is not used
+	client, err := civogo.NewClient(token.Token, "LON1")
+	if err != nil {
+		return nil, err
+	}
+	client.SetUserAgent(&civogo.Component{Name: externaldns.UserAgentProduct, Version: externaldns.Version})
+	return &civo.CivoProvider{
+		Client: *client,
+		DryRun: cfg.DryRun,
+	}, nil
+}
+
+// newDNSimpleProvider builds the dnsimple provider with the token of the credential, in its account or else the
+// account of the token
+func newDNSimpleProvider(ctx context.Context, cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, zoneIDFilter provider.ZoneIDFilter, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+	return dnsimple.NewProvider(ctx, dnsimple.Config{
+		Token:        token.Token,
+		AccountID:    token.AccountID,
+		DomainFilter: domainFilter,
+		ZoneIDFilter: zoneIDFilter,
+		DryRun:       cfg.DryRun,
+	})
+}
+
+// newNS1Provider builds the ns1 provider with the API key of the credential
+func newNS1Provider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, zoneIDFilter provider.ZoneIDFilter, cred *credentials.Credential) (provider.Provider, error) {
+	token, err := providerToken(cfg, cred)
+	if err != nil {
+		return nil, err
+	}
+	return ns1.NewProvider(ns1.Config{
+		APIKey:        token.Token,
+		Endpoint:      cfg.NS1Endpoint,
+		IgnoreSSL:     cfg.NS1IgnoreSSL,
+		DomainFilter:  domainFilter,
+		ZoneIDFilter:  zoneIDFilter,
+		MinTTLSeconds: cfg.NS1MinTTLSeconds,
+		DryRun:        cfg.DryRun,
+	})
+}
+
+// newScalewayProvider builds the scaleway provider with the access and secret keys of the credential
+func newScalewayProvider(cfg *externaldns.Config, domainFilter *endpoint.DomainFilter, cred *credentials.Credential) (provider.Provider, error) {
+	if cred == nil || cred.Scaleway == nil {
+		return nil, fmt.Errorf("no access and secret keys found for %s provider", cfg.Provider)
+	}
+	return scaleway.NewProvider(scaleway.Config{
+		AccessKey:    cred.Scaleway.AccessKey,
+		SecretKey:    cred.Scaleway.SecretKey,
+		APIURL:       cred.Scaleway.APIURL,
+		PageSize:     uint32(max(cred.Scaleway.PageSize, 0)),
+		DomainFilter: domainFilter,
+		DryRun:       cfg.DryRun,
+	})
+}
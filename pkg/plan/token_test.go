@@ -0,0 +1,158 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"github.com/digitalocean/godo"
+	"sigs.k8s.io/external-dns/endpoint"
+)
+
+// startTokenServer stands in for the API of a DNS vendor. It serves body at path to requests carrying
+// the expected authentication header, and counts them.
+func startTokenServer(t *testing.T, path, header, value, body string) (*httptest.Server, *int) {
+	t.Helper()
+
+	calls := new(int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(header) != value {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server, calls
+}
+
+func TestTokenProviders(t *testing.T) {
+	const (
+		token     = "linode-token"
+		accessKey = "SCWABCDEFGHIJKLMNOPQ"
+		secretKey = "6a9e2b9c-0b8d-4c5e-9d7f-1e2a3b4c5d6e"
+	)
+
+	tests := []struct {
+		name     string
+		provider api.Provider
+		// start returns the credential of the provider, calling the stand-in server
+		start func(t *testing.T) (*credentials.Credential, *int)
+	}{
+		{
+			name:     "linode",
+			provider: api.ProviderLinode,
+			start: func(t *testing.T) (*credentials.Credential, *int) {
+				server, calls := startTokenServer(t, "/v4/domains", "Authorization", "Bearer "+token, `{"data":[],"page":1,"pages":1,"results":0}`)
+				return &credentials.Credential{Token: &credentials.TokenCredential{Token: token, URL: server.URL}}, calls
+			},
+		},
+		{
+			name:     "scaleway",
+			provider: api.ProviderScaleway,
+			start: func(t *testing.T) (*credentials.Credential, *int) {
+				server, calls := startTokenServer(t, "/domain/v2beta1/dns-zones", "X-Auth-Token", secretKey, `{"dns_zones":[],"total_count":0}`)
+				return &credentials.Credential{Scaleway: &credentials.ScalewayCredential{AccessKey: accessKey, SecretKey: secretKey, APIURL: server.URL}}, calls
+			},
+		},
+		{
+			name:     "cloudflare",
+			provider: api.ProviderCloudflare,
+			start: func(t *testing.T) (*credentials.Credential, *int) {
+				server, calls := startTokenServer(t, "/zones", "Authorization", "Bearer "+token, `{"success":true,"errors":[],"messages":[],"result":[],"result_info":{"page":1,"per_page":20,"count":0,"total_count":0}}`)
+				return &credentials.Credential{Cloudflare: &credentials.CloudflareCredential{APIToken: token, BaseURL: server.URL}}, calls
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cred, calls := tc.start(t)
+			edns := &api.ExternalDNS{
+				Spec: api.ExternalDNSSpec{
+					Provider: tc.provider,
+				},
+			}
+			cfg := convertEDNSObjectToCfg(edns)
+
+			p, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), cred)
+			if err != nil {
+				t.Fatalf("failed to build provider: %v", err)
+			}
+			if _, err := p.Records(context.Background()); err != nil {
+				t.Fatalf("failed to list records: %v", err)
+			}
+			if *calls == 0 {
+				t.Fatal("the provider did not call the API with its credential")
+			}
+		})
+	}
+}
+
+func TestTokenProviderWithoutCredential(t *testing.T) {
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Provider: api.ProviderDigitalOcean,
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+
+	if _, err := buildProvider(context.Background(), cfg, createDomainFilter(cfg), &credentials.Credential{}); err == nil {
+		t.Fatal("expected the provider to fail without a token")
+	}
+}
+
+// domainsStub returns a single page of domains
+type domainsStub struct {
+	godo.DomainsService
+	domains []godo.Domain
+	perPage int
+}
+
+func (d *domainsStub) List(_ context.Context, opt *godo.ListOptions) ([]godo.Domain, *godo.Response, error) {
+	d.perPage = opt.PerPage
+	return d.domains, &godo.Response{}, nil
+}
+
+func TestDigitalOceanDomainFilter(t *testing.T) {
+	stub := &domainsStub{domains: []godo.Domain{{Name: "example.com"}, {Name: "example.org"}}}
+	domains := digitalOceanDomains{DomainsService: stub, domainFilter: endpoint.NewDomainFilter([]string{"example.com"}), pageSize: 50}
+
+	got, _, err := domains.List(context.Background(), &godo.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list domains: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "example.com" {
+		t.Fatalf("expected only the domain matching the filter, got %v", got)
+	}
+	if stub.perPage != 50 {
+		t.Fatalf("domains listed with page size %d, want 50", stub.perPage)
+	}
+}
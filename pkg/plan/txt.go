@@ -0,0 +1,110 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+// rotateTXTEncryption encrypts the ownership records again with the new AES key, on every sync while the secret
+// keeps the old one. It runs with the sync rather than with the cached registry, so it follows the dry run mode.
+func rotateTXTEncryption(ctx context.Context, cfg *externaldns.Config, p provider.Provider, cred *credentials.Credential) error {
+	if cfg.Registry != api.RegistryTXT.String() || cred == nil || cred.TXTEncryption == nil || len(cred.TXTEncryption.OldAESKey) == 0 {
+		return nil
+	}
+	if err := reencryptTXTRecords(ctx, cfg, p, cred.TXTEncryption); err != nil {
+		return fmt.Errorf("failed to encrypt the ownership records with the new AES key: %w", err)
+	}
+	return nil
+}
+
+// reencryptTXTRecords encrypts the ownership records of the owner that are still encrypted with the old AES
+// key again with the new one. The TXT registry decrypts with a single key, it would not recognize them otherwise.
+func reencryptTXTRecords(ctx context.Context, cfg *externaldns.Config, p provider.Provider, keys *credentials.TXTEncryptionCredential) error {
+	records, err := p.Records(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes := &plan.Changes{}
+	for _, rec := range records {
+		if rec.RecordType != endpoint.RecordTypeTXT {
+			continue
+		}
+		updated := rec.DeepCopy()
+		for i, target := range rec.Targets {
+			lbls, found := oldKeyLabels(target, keys.OldAESKey)
+			if !found || lbls[endpoint.OwnerLabelKey] != cfg.TXTOwnerID {
+				continue
+			}
+			updated.Targets[i] = lbls.Serialize(strings.HasPrefix(target, "\""), true, keys.AESKey)
+		}
+		if !slices.Equal(updated.Targets, rec.Targets) {
+			changes.UpdateOld = append(changes.UpdateOld, rec)
+			changes.UpdateNew = append(changes.UpdateNew, updated)
+		}
+	}
+
+	if len(changes.UpdateNew) == 0 {
+		return nil
+	}
+	if cfg.DryRun {
+		klog.InfoS("dry run, ownership records encrypted with the old AES key are not encrypted again", "records", len(changes.UpdateNew))
+		return nil
+	}
+	ctx = context.WithValue(ctx, provider.RecordsContextKey, records)
+	if err = p.ApplyChanges(ctx, changes); err != nil {
+		return err
+	}
+	klog.InfoS("ownership records encrypted again with the new AES key", "records", len(changes.UpdateNew))
+	return nil
+}
+
+// oldKeyLabels returns the labels of an ownership record encrypted with the old key. Records in plain text or
+// encrypted with another key are not found.
+func oldKeyLabels(target string, oldKey []byte) (endpoint.Labels, bool) {
+	if _, _, err := endpoint.DecryptText(strings.Trim(target, "\""), oldKey); err != nil {
+		return nil, false
+	}
+	// the labels keep the nonce of the record, so it is encrypted again with the same nonce
+	lbls, err := endpoint.NewLabelsFromString(target, oldKey)
+	return lbls, err == nil
+}
+
+// ownershipLabels returns the labels of an ownership record, which may still be encrypted with the old key
+// while the AES key is rotated
+func ownershipLabels(target string, cfg *externaldns.Config, cred *credentials.Credential) (endpoint.Labels, error) {
+	lbls, err := endpoint.NewLabelsFromString(target, []byte(cfg.TXTEncryptAESKey))
+	if err != nil && cred != nil && cred.TXTEncryption != nil && len(cred.TXTEncryption.OldAESKey) != 0 {
+		if oldLbls, found := oldKeyLabels(target, cred.TXTEncryption.OldAESKey); found {
+			return oldLbls, nil
+		}
+	}
+	return lbls, err
+}
@@ -0,0 +1,125 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "kubeops.dev/external-dns-operator/apis/external/v1alpha1"
+	"kubeops.dev/external-dns-operator/pkg/credentials"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider/inmemory"
+	"sigs.k8s.io/external-dns/registry"
+)
+
+func TestTXTEncryptionKeyRotation(t *testing.T) {
+	oldKey := []byte("0123456789abcdef0123456789abcdef")
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+	ctx := context.Background()
+
+	pvdr := inmemory.NewInMemoryProvider(inmemory.InMemoryInitZones([]string{"example.com"}))
+	managed := []string{endpoint.RecordTypeA}
+
+	// records of this owner and of another one, with their ownership records encrypted with the old key
+	for owner, name := range map[string]string{"default": "www.example.com", "other": "api.example.com"} {
+		reg, err := registry.NewTXTRegistry(pvdr, "", "", owner, 0, "", managed, nil, true, oldKey, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = reg.ApplyChanges(ctx, &plan.Changes{Create: []*endpoint.Endpoint{endpoint.NewEndpoint(name, endpoint.RecordTypeA, "192.0.2.10")}}); err != nil {
+			t.Fatalf("failed to create the records of %s: %v", owner, err)
+		}
+	}
+
+	edns := &api.ExternalDNS{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "txt"},
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+		},
+	}
+	cred := &credentials.Credential{TXTEncryption: &credentials.TXTEncryptionCredential{AESKey: newKey, OldAESKey: oldKey}}
+	cfg := convertEDNSObjectToCfg(edns)
+	cfg.ManagedDNSRecordTypes = managed
+	applyCredential(cfg, cred)
+	if err := validateConfig(cfg, &edns.Spec); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rotateTXTEncryption(ctx, cfg, pvdr, cred); err != nil {
+		t.Fatalf("failed to encrypt the ownership records again: %v", err)
+	}
+	reg, err := createRegistry(ctx, edns, cfg, pvdr, cred)
+	if err != nil {
+		t.Fatalf("failed to create registry: %v", err)
+	}
+
+	if _, err = reg.Records(ctx); err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+
+	// only the ownership records of the owner are encrypted again
+	txt, err := pvdr.Records(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range txt {
+		if rec.RecordType != endpoint.RecordTypeTXT {
+			continue
+		}
+		key, owner := newKey, "default"
+		if strings.Contains(rec.DNSName, "api") {
+			key, owner = oldKey, "other"
+		}
+		if _, _, err := endpoint.DecryptText(strings.Trim(rec.Targets[0], "\""), key); err != nil {
+			t.Errorf("ownership record %s is not encrypted with the expected key: %v", rec.DNSName, err)
+			continue
+		}
+		// the labels of the record are kept
+		if lbls, err := endpoint.NewLabelsFromString(rec.Targets[0], key); err != nil || lbls[endpoint.OwnerLabelKey] != owner {
+			t.Errorf("ownership record %s has labels %v, error %v", rec.DNSName, lbls, err)
+		}
+	}
+}
+
+func TestTXTEncryptionRequiresTXTRegistry(t *testing.T) {
+	edns := &api.ExternalDNS{
+		Spec: api.ExternalDNSSpec{
+			Source:       api.SourceConfig{Type: api.TypeInfo{Version: "v1", Kind: "Service"}},
+			Provider:     api.ProviderInMemory,
+			DomainFilter: []string{"example.com"},
+			Registry:     &api.Registry{Type: api.RegistryNoop},
+		},
+	}
+	cfg := convertEDNSObjectToCfg(edns)
+	applyCredential(cfg, &credentials.Credential{TXTEncryption: &credentials.TXTEncryptionCredential{AESKey: make([]byte, 32)}})
+	if err := validateConfig(cfg, &edns.Spec); err == nil {
+		t.Fatal("expected an error for TXT encryption with the noop registry")
+	}
+
+	// the txt settings are rejected with the other registries instead of being ignored
+	edns.Spec.Registry.TXT = &api.TXTRegistry{}
+	if err := validateConfig(convertEDNSObjectToCfg(edns), &edns.Spec); err == nil {
+		t.Fatal("expected an error for spec.registry.txt with the noop registry")
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scaleway implements the Scaleway provider. It follows the upstream scaleway provider, but takes the
+// access key, the secret key and the API URL from the caller instead of the environment and the scw config file,
+// so every ExternalDNS calls Scaleway with its own keys.
+package scaleway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+const (
+	defaultTTL      uint32 = 300
+	defaultPriority uint32 = 0
+	defaultPageSize uint32 = 1000
+
+	// priorityKey is the provider specific property holding the priority of the records
+	priorityKey = "scw/priority"
+)
+
+// client is the subset of the Scaleway domain API used by the provider
+type client interface {
+	ListDNSZones(req *domain.ListDNSZonesRequest, opts ...scw.RequestOption) (*domain.ListDNSZonesResponse, error)
+	ListDNSZoneRecords(req *domain.ListDNSZoneRecordsRequest, opts ...scw.RequestOption) (*domain.ListDNSZoneRecordsResponse, error)
+	UpdateDNSZoneRecords(req *domain.UpdateDNSZoneRecordsRequest, opts ...scw.RequestOption) (*domain.UpdateDNSZoneRecordsResponse, error)
+}
+
+// Config configures the Scaleway provider
+type Config struct {
+	AccessKey string
+	SecretKey string
+
+	// APIURL overrides the URL of the Scaleway API
+	APIURL string
+
+	// PageSize is the number of zones and records listed per request, 1000 when not set
+	PageSize uint32
+
+	DomainFilter *endpoint.DomainFilter
+	DryRun       bool
+}
+
+// Provider manages the records of the Scaleway DNS zones
+type Provider struct {
+	provider.BaseProvider
+	client       client
+	domainFilter *endpoint.DomainFilter
+	dryRun       bool
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns a Scaleway provider calling the API with the keys of config
+func NewProvider(config Config) (*Provider, error) {
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("no access key and secret key found for scaleway provider")
+	}
+
+	pageSize := config.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	opts := []scw.ClientOption{
+		scw.WithAuth(config.AccessKey, config.SecretKey),
+		scw.WithUserAgent(externaldns.UserAgent()),
+		scw.WithDefaultPageSize(pageSize),
+	}
+	if config.APIURL != "" {
+		opts = append(opts, scw.WithAPIURL(config.APIURL))
+	}
+
+	scwClient, err := scw.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newProvider(domain.NewAPI(scwClient), config), nil
+}
+
+func newProvider(c client, config Config) *Provider {
+	return &Provider{
+		client:       c,
+		domainFilter: config.DomainFilter,
+		dryRun:       config.DryRun,
+	}
+}
+
+// AdjustEndpoints sets the default TTL and priority of the endpoints
+func (p *Provider) AdjustEndpoints(endpoints []*endpoint.Endpoint) ([]*endpoint.Endpoint, error) {
+	eps := make([]*endpoint.Endpoint, len(endpoints))
+	for i := range endpoints {
+		eps[i] = endpoints[i]
+		if !eps[i].RecordTTL.IsConfigured() {
+			eps[i].RecordTTL = endpoint.TTL(defaultTTL)
+		}
+		if _, ok := eps[i].GetProviderSpecificProperty(priorityKey); !ok {
+			eps[i] = eps[i].WithProviderSpecific(priorityKey, fmt.Sprintf("%d", defaultPriority))
+		}
+	}
+	return eps, nil
+}
+
+// Zones returns the zones matching the domain filter
+func (p *Provider) Zones(ctx context.Context) ([]*domain.DNSZone, error) {
+	dnsZones, err := p.client.ListDNSZones(&domain.ListDNSZonesRequest{}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []*domain.DNSZone
+	for _, dnsZone := range dnsZones.DNSZones {
+		if p.domainFilter.Match(completeZoneName(dnsZone)) {
+			zones = append(zones, dnsZone)
+		}
+	}
+	return zones, nil
+}
+
+// Records returns the records of the zones. The records of a name and type share one endpoint, with the TTL and
+// the priority of the first record.
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	dnsZones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := map[string]*endpoint.Endpoint{}
+	for _, zone := range dnsZones {
+		resp, err := p.client.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+			DNSZone: completeZoneName(zone),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range resp.Records {
+			// trim the dot of the apex records, which have an empty name
+			name := strings.Trim(record.Name+"."+completeZoneName(zone), ".")
+			if !provider.SupportedRecordType(record.Type.String()) {
+				klog.V(4).Infof("skipping record %s because type %s is not supported", name, record.Type)
+				continue
+			}
+
+			key := record.Type.String() + "/" + name
+			if ep, ok := endpoints[key]; ok {
+				ep.Targets = append(ep.Targets, record.Data)
+				continue
+			}
+			endpoints[key] = endpoint.NewEndpointWithTTL(name, record.Type.String(), endpoint.TTL(record.TTL), record.Data).
+				WithProviderSpecific(priorityKey, fmt.Sprintf("%d", record.Priority))
+		}
+	}
+
+	result := make([]*endpoint.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		result = append(result, ep)
+	}
+	return result, nil
+}
+
+// ApplyChanges sends the changes of every zone in one request
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	requests, err := p.applyRequests(ctx, changes)
+	if err != nil {
+		return err
+	}
+	for _, req := range requests {
+		logChanges(req)
+		if p.dryRun {
+			continue
+		}
+		if _, err := p.client.UpdateDNSZoneRecords(req, scw.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyRequests returns the update requests of the zones having changes. The old and deleted records are removed
+// before the new ones are added.
+func (p *Provider) applyRequests(ctx context.Context, changes *plan.Changes) ([]*domain.UpdateDNSZoneRecordsRequest, error) {
+	dnsZones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneNames := provider.ZoneIDName{}
+	toAdd := map[string]*domain.RecordChangeAdd{}
+	toDelete := map[string][]*domain.RecordChange{}
+	for _, zone := range dnsZones {
+		name := completeZoneName(zone)
+		zoneNames.Add(name, name)
+		toAdd[name] = &domain.RecordChangeAdd{Records: []*domain.Record{}}
+		toDelete[name] = []*domain.RecordChange{}
+	}
+
+	for _, ep := range slices.Concat(changes.UpdateOld, changes.Delete) {
+		zone, _ := zoneNames.FindZone(ep.DNSName)
+		if zone == "" {
+			klog.V(4).Infof("skipping record %s because no hosted zone matches its DNS name", ep.DNSName)
+			continue
+		}
+		toDelete[zone] = append(toDelete[zone], deleteChanges(zone, ep)...)
+	}
+	for _, ep := range slices.Concat(changes.Create, changes.UpdateNew) {
+		zone, _ := zoneNames.FindZone(ep.DNSName)
+		if zone == "" {
+			klog.V(4).Infof("skipping record %s because no hosted zone matches its DNS name", ep.DNSName)
+			continue
+		}
+		toAdd[zone].Records = append(toAdd[zone].Records, records(zone, ep)...)
+	}
+
+	var requests []*domain.UpdateDNSZoneRecordsRequest
+	for _, zone := range dnsZones {
+		name := completeZoneName(zone)
+		if len(toDelete[name]) == 0 && len(toAdd[name].Records) == 0 {
+			continue
+		}
+		requests = append(requests, &domain.UpdateDNSZoneRecordsRequest{
+			DNSZone: name,
+			Changes: append(toDelete[name], &domain.RecordChange{Add: toAdd[name]}),
+		})
+	}
+	return requests, nil
+}
+
+// completeZoneName returns the name of zone, with its subdomain
+func completeZoneName(zone *domain.DNSZone) string {
+	if zone.Subdomain == "" {
+		return zone.Domain
+	}
+	return zone.Subdomain + "." + zone.Domain
+}
+
+// recordName returns the name of ep relative to the zone
+func recordName(zone string, ep *endpoint.Endpoint) string {
+	return strings.Trim(strings.TrimSuffix(ep.DNSName, zone), ". ")
+}
+
+// recordData returns the data of a record of ep, CNAME targets are fully qualified
+func recordData(ep *endpoint.Endpoint, target string) string {
+	if domain.RecordType(ep.RecordType) == domain.RecordTypeCNAME {
+		return provider.EnsureTrailingDot(target)
+	}
+	return target
+}
+
+// records returns the Scaleway records of the targets of ep
+func records(zone string, ep *endpoint.Endpoint) []*domain.Record {
+	ttl := defaultTTL
+	if ep.RecordTTL.IsConfigured() {
+		ttl = uint32(ep.RecordTTL)
+	}
+	priority := defaultPriority
+	if prop, ok := ep.GetProviderSpecificProperty(priorityKey); ok {
+		if prio, err := strconv.ParseUint(prop, 10, 32); err != nil {
+			klog.Errorf("failed to parse %s %q of record %s, using priority %d: %v", priorityKey, prop, ep.DNSName, defaultPriority, err)
+		} else {
+			priority = uint32(prio)
+		}
+	}
+
+	result := make([]*domain.Record, 0, len(ep.Targets))
+	for _, target := range ep.Targets {
+		result = append(result, &domain.Record{
+			Data:     recordData(ep, target),
+			Name:     recordName(zone, ep),
+			Priority: priority,
+			TTL:      ttl,
+			Type:     domain.RecordType(ep.RecordType),
+		})
+	}
+	return result
+}
+
+// deleteChanges returns the changes deleting the records of the targets of ep
+func deleteChanges(zone string, ep *endpoint.Endpoint) []*domain.RecordChange {
+	result := make([]*domain.RecordChange, 0, len(ep.Targets))
+	for _, target := range ep.Targets {
+		data := recordData(ep, target)
+		result = append(result, &domain.RecordChange{
+			Delete: &domain.RecordChangeDelete{
+				IDFields: &domain.RecordIdentifier{
+					Data: &data,
+					Name: recordName(zone, ep),
+					Type: domain.RecordType(ep.RecordType),
+				},
+			},
+		})
+	}
+	return result
+}
+
+func logChanges(req *domain.UpdateDNSZoneRecordsRequest) {
+	for _, change := range req.Changes {
+		switch {
+		case change.Add != nil:
+			for _, add := range change.Add.Records {
+				klog.InfoS("adding record", "zone", req.DNSZone, "name", add.Name, "type", add.Type.String(), "ttl", add.TTL, "priority", add.Priority, "data", add.Data)
+			}
+		case change.Delete != nil && change.Delete.IDFields != nil:
+			klog.InfoS("deleting record", "zone", req.DNSZone, "name", change.Delete.IDFields.Name, "type", change.Delete.IDFields.Type.String(), "data", *change.Delete.IDFields.Data)
+		}
+	}
+}
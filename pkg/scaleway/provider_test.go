@@ -0,0 +1,148 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+type fakeClient struct {
+	updates []*domain.UpdateDNSZoneRecordsRequest
+}
+
+func (c *fakeClient) ListDNSZones(_ *domain.ListDNSZonesRequest, _ ...scw.RequestOption) (*domain.ListDNSZonesResponse, error) {
+	return &domain.ListDNSZonesResponse{DNSZones: []*domain.DNSZone{
+		{Domain: "example.com"},
+		{Domain: "example.com", Subdomain: "dev"},
+		{Domain: "example.org"},
+	}}, nil
+}
+
+func (c *fakeClient) ListDNSZoneRecords(req *domain.ListDNSZoneRecordsRequest, _ ...scw.RequestOption) (*domain.ListDNSZoneRecordsResponse, error) {
+	return &domain.ListDNSZoneRecordsResponse{Records: []*domain.Record{
+		{Name: "", Type: domain.RecordTypeA, Data: "192.0.2.1", TTL: 300},
+		{Name: "", Type: domain.RecordTypeA, Data: "192.0.2.2", TTL: 600},
+		{Name: "www", Type: domain.RecordTypeCNAME, Data: req.DNSZone + ".", TTL: 300, Priority: 10},
+		{Name: "", Type: domain.RecordTypeMX, Data: "10 mail.example.com."},
+	}}, nil
+}
+
+func (c *fakeClient) UpdateDNSZoneRecords(req *domain.UpdateDNSZoneRecordsRequest, _ ...scw.RequestOption) (*domain.UpdateDNSZoneRecordsResponse, error) {
+	c.updates = append(c.updates, req)
+	return &domain.UpdateDNSZoneRecordsResponse{}, nil
+}
+
+func TestProviderRecords(t *testing.T) {
+	p := newProvider(&fakeClient{}, Config{DomainFilter: endpoint.NewDomainFilter([]string{"example.com"})})
+
+	records, err := p.Records(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	// the A records of the apex share one endpoint, the MX records are skipped
+	if len(records) != 4 {
+		t.Fatalf("unexpected records: %v", records)
+	}
+	for _, ep := range records {
+		if ep.DNSName == "example.com" && ep.RecordType == endpoint.RecordTypeA && (len(ep.Targets) != 2 || ep.RecordTTL != 300) {
+			t.Fatalf("unexpected apex record: %v", ep)
+		}
+		if ep.DNSName == "www.dev.example.com" {
+			if priority, _ := ep.GetProviderSpecificProperty(priorityKey); priority != "10" {
+				t.Fatalf("priority = %q, want 10", priority)
+			}
+		}
+	}
+}
+
+func TestProviderApplyChanges(t *testing.T) {
+	c := &fakeClient{}
+	p := newProvider(c, Config{DomainFilter: endpoint.NewDomainFilter(nil)})
+
+	changes := &plan.Changes{
+		Create:    []*endpoint.Endpoint{endpoint.NewEndpoint("api.dev.example.com", endpoint.RecordTypeCNAME, "example.org")},
+		UpdateOld: []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.org", endpoint.RecordTypeA, "192.0.2.1")},
+		UpdateNew: []*endpoint.Endpoint{endpoint.NewEndpointWithTTL("www.example.org", endpoint.RecordTypeA, 60, "192.0.2.2")},
+		Delete:    []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.net", endpoint.RecordTypeA, "192.0.2.3")},
+	}
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+
+	updates := map[string]*domain.UpdateDNSZoneRecordsRequest{}
+	for _, req := range c.updates {
+		updates[req.DNSZone] = req
+	}
+	if len(updates) != 2 {
+		t.Fatalf("unexpected updates: %v", c.updates)
+	}
+	// the CNAME is added to the zone of the longest name, with a fully qualified target
+	if add := updates["dev.example.com"].Changes[0].Add.Records; len(add) != 1 || add[0].Name != "api" || add[0].Data != "example.org." {
+		t.Fatalf("unexpected records added to dev.example.com: %v", add)
+	}
+	// the old record is deleted before the new one is added
+	org := updates["example.org"].Changes
+	if len(org) != 2 || org[0].Delete == nil || *org[0].Delete.IDFields.Data != "192.0.2.1" || org[1].Add.Records[0].TTL != 60 {
+		t.Fatalf("unexpected changes of example.org: %v", org)
+	}
+
+	c.updates = nil
+	p.dryRun = true
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+	if len(c.updates) != 0 {
+		t.Fatalf("dry run provider sent updates: %v", c.updates)
+	}
+}
+
+func TestProviderWithKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "11111111-1111-1111-1111-111111111111" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"dns_zones":[{"domain":"example.com","subdomain":""}],"total_count":1}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(Config{AccessKey: "SCWXXXXXXXXXXXXXXXXX", SecretKey: "11111111-1111-1111-1111-111111111111", APIURL: server.URL, DomainFilter: endpoint.NewDomainFilter(nil)})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	zones, err := p.Zones(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list zones: %v", err)
+	}
+	if len(zones) != 1 || zones[0].Domain != "example.com" {
+		t.Fatalf("unexpected zones: %v", zones)
+	}
+
+	if _, err := NewProvider(Config{AccessKey: "SCWXXXXXXXXXXXXXXXXX"}); err == nil {
+		t.Fatal("expected an error without a secret key")
+	}
+}
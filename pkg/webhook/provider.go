@@ -0,0 +1,220 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the client side of the external-dns webhook provider protocol. It
+// follows the upstream webhook provider, but takes the HTTP client and the timeouts from the
+// caller, so every ExternalDNS calls its webhook with its own token and certificates.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+	webhookapi "sigs.k8s.io/external-dns/provider/webhook/api"
+)
+
+const acceptHeader = "Accept"
+
+// Provider calls a webhook provider over HTTP
+type Provider struct {
+	// ctx is the context the provider is created in, it bounds the calls of the methods taking no context
+	ctx context.Context
+
+	client       *http.Client
+	url          *url.URL
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	domainFilter *endpoint.DomainFilter
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewClient returns an HTTP client sending token as bearer token, and using tlsConfig when it is not nil
+func NewClient(token string, tlsConfig *tls.Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	var rt http.RoundTripper = transport
+	if token != "" {
+		rt = &bearerTransport{token: token, next: transport}
+	}
+	return &http.Client{Transport: rt}
+}
+
+type bearerTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.next.RoundTrip(req)
+}
+
+// NewProvider negotiates the API with the webhook provider at u and returns a provider calling it. Canceling
+// ctx cancels the calls of AdjustEndpoints, which takes no context.
+func NewProvider(ctx context.Context, u string, client *http.Client, readTimeout, writeTimeout time.Duration) (*Provider, error) {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		ctx:          ctx,
+		client:       client,
+		url:          parsedURL,
+		readTimeout:  readTimeout,
+		writeTimeout: writeTimeout,
+		domainFilter: &endpoint.DomainFilter{},
+	}
+
+	// negotiate API information, the response is the domain filter of the webhook provider
+	resp, err := p.do(ctx, p.readTimeout, http.MethodGet, p.url.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to webhook: %w", err)
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to connect to webhook: status code %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get(webhookapi.ContentTypeHeader); ct != webhookapi.MediaTypeFormatAndVersion {
+		return nil, fmt.Errorf("wrong content type returned from server: %s", ct)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(p.domainFilter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body of DomainFilter: %w", err)
+	}
+	return p, nil
+}
+
+// do sends a request with the given timeout. The response body is read before returning, so
+// the timeout does not cancel the caller reading it.
+func (p *Provider) do(ctx context.Context, timeout time.Duration, method, u string, body any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		b := new(bytes.Buffer)
+		if err := json.NewEncoder(b).Encode(body); err != nil {
+			return nil, err
+		}
+		reader = b
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(acceptHeader, webhookapi.MediaTypeFormatAndVersion)
+	if body != nil {
+		req.Header.Set(webhookapi.ContentTypeHeader, webhookapi.MediaTypeFormatAndVersion)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+// statusError returns the error of an unexpected status code, a soft error when the request can be retried
+func statusError(op string, statusCode int) error {
+	err := fmt.Errorf("failed to %s with code %d", op, statusCode)
+	if statusCode >= http.StatusInternalServerError && statusCode <= http.StatusNotExtended {
+		return provider.NewSoftError(err)
+	}
+	return err
+}
+
+// Records returns the records of the webhook provider
+func (p *Provider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
+	resp, err := p.do(ctx, p.readTimeout, http.MethodGet, p.url.JoinPath(webhookapi.UrlRecords).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError("get records", resp.StatusCode)
+	}
+
+	var endpoints []*endpoint.Endpoint
+	if err := json.NewDecoder(resp.Body).Decode(&endpoints); err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+// ApplyChanges sends the changes to the webhook provider
+func (p *Provider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
+	resp, err := p.do(ctx, p.writeTimeout, http.MethodPost, p.url.JoinPath(webhookapi.UrlRecords).String(), changes)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusNoContent {
+		return statusError("apply changes", resp.StatusCode)
+	}
+	return nil
+}
+
+// AdjustEndpoints lets the webhook provider modify the endpoints according to its own requirements
+func (p *Provider) AdjustEndpoints(endpoints []*endpoint.Endpoint) ([]*endpoint.Endpoint, error) {
+	resp, err := p.do(p.ctx, p.readTimeout, http.MethodPost, p.url.JoinPath(webhookapi.UrlAdjustEndpoints).String(), endpoints)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError("adjust endpoints", resp.StatusCode)
+	}
+
+	var adjusted []*endpoint.Endpoint
+	if err := json.NewDecoder(resp.Body).Decode(&adjusted); err != nil {
+		return nil, err
+	}
+	return adjusted, nil
+}
+
+// GetDomainFilter returns the domain filter negotiated with the webhook provider
+func (p *Provider) GetDomainFilter() endpoint.DomainFilterInterface {
+	return p.domainFilter
+}
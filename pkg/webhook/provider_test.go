@@ -0,0 +1,143 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider/inmemory"
+	webhookapi "sigs.k8s.io/external-dns/provider/webhook/api"
+)
+
+const testToken = "webhook-test-token"
+
+// startWebhookServer serves an in-memory provider through the upstream webhook API, to requests carrying testToken
+func startWebhookServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	p := webhookapi.WebhookServer{
+		Provider: inmemory.NewInMemoryProvider(inmemory.InMemoryInitZones([]string{"example.com"})),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", p.NegotiateHandler)
+	mux.HandleFunc(webhookapi.UrlRecords, p.RecordsHandler)
+	mux.HandleFunc(webhookapi.UrlAdjustEndpoints, p.AdjustEndpointsHandler)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProviderWithBearerToken(t *testing.T) {
+	server := startWebhookServer(t)
+	ctx := context.Background()
+
+	p, err := NewProvider(ctx, server.URL, NewClient(testToken, nil), 5*time.Second, 10*time.Second)
+	if err != nil {
+		t.Fatalf("failed to negotiate with webhook: %v", err)
+	}
+
+	created := endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")
+	adjusted, err := p.AdjustEndpoints([]*endpoint.Endpoint{created})
+	if err != nil || len(adjusted) != 1 {
+		t.Fatalf("AdjustEndpoints = %v, %v", adjusted, err)
+	}
+	if err := p.ApplyChanges(ctx, &plan.Changes{Create: adjusted}); err != nil {
+		t.Fatalf("failed to apply changes: %v", err)
+	}
+
+	records, err := p.Records(ctx)
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) != 1 || records[0].DNSName != "www.example.com" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+}
+
+func TestProviderWithoutBearerToken(t *testing.T) {
+	server := startWebhookServer(t)
+
+	if _, err := NewProvider(context.Background(), server.URL, NewClient("", nil), 5*time.Second, 10*time.Second); err == nil {
+		t.Fatal("expected negotiation without the token to fail")
+	}
+}
+
+func TestAdjustEndpointsCanceled(t *testing.T) {
+	negotiate := startWebhookServer(t)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == webhookapi.UrlAdjustEndpoints {
+			// the webhook provider does not answer until the test ends
+			<-release
+			return
+		}
+		r.Header.Set("Authorization", "Bearer "+testToken)
+		negotiate.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	endpoints := []*endpoint.Endpoint{endpoint.NewEndpoint("www.example.com", endpoint.RecordTypeA, "192.0.2.10")}
+	adjust := func(p *Provider) error {
+		t.Helper()
+		done := make(chan error, 1)
+		go func() {
+			_, err := p.AdjustEndpoints(endpoints)
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			return err
+		case <-time.After(5 * time.Second):
+			t.Fatal("AdjustEndpoints did not return")
+			return nil
+		}
+	}
+
+	// the read timeout bounds the call
+	p, err := NewProvider(context.Background(), server.URL, NewClient("", nil), 100*time.Millisecond, 0)
+	if err != nil {
+		t.Fatalf("failed to negotiate with webhook: %v", err)
+	}
+	if err := adjust(p); err == nil {
+		t.Fatal("expected AdjustEndpoints to time out")
+	}
+
+	// so does the context of the provider, without a read timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	p, err = NewProvider(ctx, server.URL, NewClient("", nil), 0, 0)
+	if err != nil {
+		t.Fatalf("failed to negotiate with webhook: %v", err)
+	}
+	time.AfterFunc(100*time.Millisecond, cancel)
+	if err := adjust(p); err == nil {
+		t.Fatal("expected AdjustEndpoints to be canceled")
+	}
+}